@@ -0,0 +1,157 @@
+package mistral
+
+// AgentToolType identifies the kind of a tool available to an agent or a conversation.
+type AgentToolType string
+
+const (
+	// AgentToolTypeFunction is a client-side function described by a JSON Schema.
+	// The model requests calls that your application executes.
+	AgentToolTypeFunction AgentToolType = "function"
+
+	// AgentToolTypeWebSearch lets the agent search the web.
+	AgentToolTypeWebSearch AgentToolType = "web_search"
+
+	// AgentToolTypeWebSearchPremium lets the agent search the web and verified news sources.
+	AgentToolTypeWebSearchPremium AgentToolType = "web_search_premium"
+
+	// AgentToolTypeCodeInterpreter lets the agent run code in a sandbox.
+	AgentToolTypeCodeInterpreter AgentToolType = "code_interpreter"
+
+	// AgentToolTypeImageGeneration lets the agent generate images.
+	AgentToolTypeImageGeneration AgentToolType = "image_generation"
+
+	// AgentToolTypeDocumentLibrary lets the agent search the documents of one or more libraries.
+	AgentToolTypeDocumentLibrary AgentToolType = "document_library"
+)
+
+// AgentTool represents a tool available to an agent or a conversation.
+// Unlike Tool, which only describes functions, AgentTool also covers the built-in connectors
+// executed on the Mistral servers. Type selects the variant; only the fields relevant to that
+// variant should be set:
+//   - AgentToolTypeFunction requires Function
+//   - AgentToolTypeDocumentLibrary requires LibraryIDs
+//   - The other types take no additional fields
+type AgentTool struct {
+	// Type is the kind of tool.
+	Type AgentToolType `json:"type"`
+
+	// Function is the specification of the function. Only used with AgentToolTypeFunction.
+	Function *ToolFunctionDetails `json:"function,omitempty"`
+
+	// LibraryIDs are the IDs of the libraries to search. Only used with
+	// AgentToolTypeDocumentLibrary.
+	LibraryIDs []string `json:"library_ids,omitempty"`
+}
+
+// AgentCreationRequest represents a request to create a new agent.
+type AgentCreationRequest struct {
+	// Model is the ID of the model the agent uses (e.g., "mistral-medium-latest"). This is required.
+	Model string `json:"model"`
+
+	// Name is a human-readable name for the agent. This is required.
+	Name string `json:"name"`
+
+	// Description is an optional description of the agent's purpose.
+	Description string `json:"description,omitempty"`
+
+	// Instructions is the instruction prompt the agent follows during conversations.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Tools is the list of tools available to the agent.
+	Tools []AgentTool `json:"tools,omitempty"`
+
+	// CompletionArgs are the generation parameters used for the agent's responses.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+
+	// Handoffs are the IDs of the agents this agent can hand off conversations to.
+	Handoffs []string `json:"handoffs,omitempty"`
+}
+
+// AgentUpdateRequest represents a request to update an agent. Updating an agent creates a
+// new version of it. Only the fields that are set are changed.
+type AgentUpdateRequest struct {
+	// Model is the ID of the model the agent uses.
+	Model string `json:"model,omitempty"`
+
+	// Name is a human-readable name for the agent.
+	Name string `json:"name,omitempty"`
+
+	// Description is a description of the agent's purpose.
+	Description string `json:"description,omitempty"`
+
+	// Instructions is the instruction prompt the agent follows during conversations.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Tools is the list of tools available to the agent.
+	Tools []AgentTool `json:"tools,omitempty"`
+
+	// CompletionArgs are the generation parameters used for the agent's responses.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+
+	// Handoffs are the IDs of the agents this agent can hand off conversations to.
+	Handoffs []string `json:"handoffs,omitempty"`
+}
+
+// ListAgentsParams represents optional parameters for paginating agent lists.
+// All fields are optional; omit them or use zero values to use defaults.
+type ListAgentsParams struct {
+	// Page is the page number to retrieve (0-indexed). If 0, returns the first page.
+	Page int
+
+	// PageSize is the number of agents to return per page. If 0, uses the API's default page size.
+	PageSize int
+}
+
+// AgentsCompletionRequest represents a request to the agents completions API.
+// It is similar to ChatCompletionRequest, but the model, instructions and tools are taken
+// from the agent identified by AgentID, as configured via CreateAgent or in the console.
+type AgentsCompletionRequest struct {
+	// AgentID is the ID of the agent to use for this completion. This is required.
+	AgentID string `json:"agent_id"`
+
+	// Messages is an array of ChatMessage objects that form the conversation history.
+	// Must contain at least one message.
+	Messages []ChatMessage `json:"messages"`
+
+	// MaxTokens is the maximum number of tokens to generate in the completion.
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
+	// Stream indicates whether to stream partial message deltas as server-sent events.
+	// If true, use CreateAgentCompletionStream instead of CreateAgentCompletion.
+	Stream bool `json:"stream,omitempty"`
+
+	// Stop contains sequences where the API will stop generating further tokens.
+	Stop []string `json:"stop,omitempty"`
+
+	// RandomSeed, if specified, makes the system attempt to sample deterministically.
+	RandomSeed *int `json:"random_seed,omitempty"`
+
+	// ResponseFormat specifies the format of the response.
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+
+	// Tools is a list of additional function tools the model may call.
+	Tools []Tool `json:"tools,omitempty"`
+
+	// ToolChoice controls how the model uses the provided tools.
+	ToolChoice ToolChoice `json:"tool_choice,omitempty"`
+
+	// PresencePenalty is a number between -2.0 and 2.0 penalizing tokens that already appeared.
+	PresencePenalty *float64 `json:"presence_penalty,omitempty"`
+
+	// FrequencyPenalty is a number between -2.0 and 2.0 penalizing frequently repeated tokens.
+	FrequencyPenalty *float64 `json:"frequency_penalty,omitempty"`
+
+	// N is how many completion choices to generate for each input message.
+	N *int `json:"n,omitempty"`
+
+	// Prediction is the expected output used to speed up generation.
+	Prediction *Prediction `json:"prediction,omitempty"`
+
+	// ParallelToolCalls controls whether the model may request several tool calls at once.
+	// Defaults to true.
+	ParallelToolCalls *bool `json:"parallel_tool_calls,omitempty"`
+
+	// PromptMode selects the system prompt of reasoning models (see PromptMode). If empty, the
+	// field is omitted and the server default applies.
+	PromptMode PromptMode `json:"prompt_mode,omitempty"`
+}
@@ -0,0 +1,247 @@
+package mistral
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultMaxImageSize is the largest image NewImageChunk inlines when
+	// AttachmentOptions.MaxSize is 0.
+	DefaultMaxImageSize int64 = 10 << 20
+
+	// DefaultMaxDocumentSize is the largest document NewDocumentChunk inlines when
+	// AttachmentOptions.MaxSize is 0.
+	DefaultMaxDocumentSize int64 = 50 << 20
+)
+
+// attachmentExtensions maps the MIME types of supported attachments to a file extension,
+// used to name uploads.
+var attachmentExtensions = map[string]string{
+	"image/png":       ".png",
+	"image/jpeg":      ".jpg",
+	"image/webp":      ".webp",
+	"image/gif":       ".gif",
+	"application/pdf": ".pdf",
+}
+
+// isImageMIMEType reports whether mimeType is an image format accepted by vision models.
+func isImageMIMEType(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "image/") && attachmentExtensions[mimeType] != ""
+}
+
+// documentMIMEType is the document format accepted by document understanding models.
+const documentMIMEType = "application/pdf"
+
+// AttachmentOptions configures how local images and documents are attached to a message.
+// Pass nil to use the defaults.
+type AttachmentOptions struct {
+	// MaxSize is the largest attachment, in bytes, embedded in the message as a base64 data
+	// URL. Larger attachments are rejected with an *AttachmentTooLargeError, except documents
+	// passed to Client.Attach, which are uploaded. If 0, DefaultMaxImageSize or DefaultMaxDocumentSize is used.
+	MaxSize int64
+
+	// Detail is the level of detail images are processed with ("low", "high" or "auto").
+	// Ignored for documents.
+	Detail string
+
+	// Name is the file name of the attachment. It is used as the DocumentName of documents
+	// and as the file name of uploads. NewImageChunkFromFile, NewDocumentChunkFromFile and
+	// Client.AttachFile default it to the base name of the path.
+	Name string
+
+	// Purpose is the purpose of documents uploaded by Client.Attach. If empty, FilePurposeOCR is used.
+	Purpose FilePurpose
+}
+
+// AttachmentTooLargeError is returned when an attachment exceeds the size limit for inlining.
+type AttachmentTooLargeError struct {
+	// MaxSize is the size limit, in bytes.
+	MaxSize int64
+}
+
+// Error implements the error interface for AttachmentTooLargeError.
+func (e *AttachmentTooLargeError) Error() string {
+	return fmt.Sprintf("attachment exceeds the maximum inline size of %d bytes", e.MaxSize)
+}
+
+// NewImageChunk reads an image from r and returns it as an ImageURLChunk holding a base64
+// data URL. The format is detected from the content and must be PNG, JPEG, WebP or GIF.
+//
+// Example:
+//
+//	chunk, err := mistral.NewImageChunk(bytes.NewReader(screenshot), &mistral.AttachmentOptions{Detail: "high"})
+//	if err != nil {
+//	    return err
+//	}
+//	msg := mistral.ChatMessage{
+//	    Role:    mistral.RoleUser,
+//	    Content: mistral.ChunkContent(mistral.TextChunk{Text: "What is wrong here?"}, chunk),
+//	}
+func NewImageChunk(r io.Reader, opts *AttachmentOptions) (ImageURLChunk, error) {
+	if opts == nil {
+		opts = &AttachmentOptions{}
+	}
+
+	mimeType, r, err := sniffAttachment(r)
+	if err != nil {
+		return ImageURLChunk{}, err
+	}
+	if !isImageMIMEType(mimeType) {
+		return ImageURLChunk{}, fmt.Errorf("unsupported image type %q", mimeType)
+	}
+
+	chunk, _, err := inlineAttachment(mimeType, r, opts)
+	if err != nil {
+		return ImageURLChunk{}, err
+	}
+	return chunk.(ImageURLChunk), nil
+}
+
+// NewImageChunkFromFile reads the image at path and returns it as an ImageURLChunk holding a
+// base64 data URL. See NewImageChunk.
+func NewImageChunkFromFile(path string, opts *AttachmentOptions) (ImageURLChunk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ImageURLChunk{}, err
+	}
+	defer f.Close()
+
+	return NewImageChunk(f, opts)
+}
+
+// NewDocumentChunk reads a PDF document from r and returns it as a DocumentURLChunk holding
+// a base64 data URL.
+//
+// Example:
+//
+//	chunk, err := mistral.NewDocumentChunkFromFile("invoice.pdf", nil)
+//	if err != nil {
+//	    return err
+//	}
+//	msg := mistral.ChatMessage{
+//	    Role:    mistral.RoleUser,
+//	    Content: mistral.ChunkContent(mistral.TextChunk{Text: "What is the total amount?"}, chunk),
+//	}
+func NewDocumentChunk(r io.Reader, opts *AttachmentOptions) (DocumentURLChunk, error) {
+	if opts == nil {
+		opts = &AttachmentOptions{}
+	}
+
+	mimeType, r, err := sniffAttachment(r)
+	if err != nil {
+		return DocumentURLChunk{}, err
+	}
+	if mimeType != documentMIMEType {
+		return DocumentURLChunk{}, fmt.Errorf("unsupported document type %q", mimeType)
+	}
+
+	chunk, _, err := inlineAttachment(mimeType, r, opts)
+	if err != nil {
+		return DocumentURLChunk{}, err
+	}
+	return chunk.(DocumentURLChunk), nil
+}
+
+// NewDocumentChunkFromFile reads the PDF document at path and returns it as a
+// DocumentURLChunk holding a base64 data URL. The base name of path is used as the
+// document name unless opts.Name is set. See NewDocumentChunk.
+func NewDocumentChunkFromFile(path string, opts *AttachmentOptions) (DocumentURLChunk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return DocumentURLChunk{}, err
+	}
+	defer f.Close()
+
+	return NewDocumentChunk(f, withAttachmentName(opts, path))
+}
+
+// inlineAttachment reads an image or PDF document of the given MIME type from r and returns
+// it as an ImageURLChunk or DocumentURLChunk holding a base64 data URL. If it exceeds the
+// inline size limit, it returns an *AttachmentTooLargeError, and rest replays the whole
+// content of r.
+func inlineAttachment(mimeType string, r io.Reader, opts *AttachmentOptions) (chunk ContentChunk, rest io.Reader, err error) {
+	var maxSize int64
+	switch {
+	case isImageMIMEType(mimeType):
+		maxSize = attachmentMaxSize(opts, DefaultMaxImageSize)
+	case mimeType == documentMIMEType:
+		maxSize = attachmentMaxSize(opts, DefaultMaxDocumentSize)
+	default:
+		return nil, nil, fmt.Errorf("unsupported attachment type %q", mimeType)
+	}
+
+	data, rest, err := readAttachment(r, maxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rest != nil {
+		return nil, rest, &AttachmentTooLargeError{MaxSize: maxSize}
+	}
+
+	if mimeType == documentMIMEType {
+		return DocumentURLChunk{DocumentURL: dataURL(mimeType, data), DocumentName: opts.Name}, nil, nil
+	}
+	return ImageURLChunk{ImageURL: ImageURL{URL: dataURL(mimeType, data), Detail: opts.Detail}}, nil, nil
+}
+
+// sniffAttachment detects the MIME type of r from its first bytes. It returns a reader
+// replaying the whole content of r.
+func sniffAttachment(r io.Reader) (string, io.Reader, error) {
+	br := bufio.NewReaderSize(r, 512)
+	head, err := br.Peek(512)
+	if err != nil && err != io.EOF {
+		return "", nil, err
+	}
+
+	mimeType := http.DetectContentType(head)
+	if i := strings.IndexByte(mimeType, ';'); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+	return mimeType, br, nil
+}
+
+// readAttachment reads r if it is at most maxSize bytes long. Otherwise data is nil and rest
+// replays the whole content of r, including the part already read.
+func readAttachment(r io.Reader, maxSize int64) (data []byte, rest io.Reader, err error) {
+	data, err = io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, io.MultiReader(bytes.NewReader(data), r), nil
+	}
+	return data, nil, nil
+}
+
+// attachmentMaxSize returns the inline size limit configured by opts, or defaultSize.
+func attachmentMaxSize(opts *AttachmentOptions, defaultSize int64) int64 {
+	if opts.MaxSize > 0 {
+		return opts.MaxSize
+	}
+	return defaultSize
+}
+
+// withAttachmentName returns a copy of opts with Name defaulted to the base name of path.
+func withAttachmentName(opts *AttachmentOptions, path string) *AttachmentOptions {
+	o := AttachmentOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Name == "" {
+		o.Name = filepath.Base(path)
+	}
+	return &o
+}
+
+// dataURL encodes data as a base64 data URL.
+func dataURL(mimeType string, data []byte) string {
+	return "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data)
+}
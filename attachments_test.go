@@ -0,0 +1,64 @@
+package mistral
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPNG is the signature of a PNG file, enough for MIME type detection.
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// testPDF is the header of a PDF file, enough for MIME type detection.
+var testPDF = []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+func TestNewImageChunk(t *testing.T) {
+	chunk, err := NewImageChunk(bytes.NewReader(testPNG), &AttachmentOptions{Detail: "high"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "data:image/png;base64,"+base64.StdEncoding.EncodeToString(testPNG), chunk.ImageURL.URL)
+	assert.Equal(t, "high", chunk.ImageURL.Detail)
+}
+
+func TestNewImageChunkRejectsOtherTypes(t *testing.T) {
+	_, err := NewImageChunk(bytes.NewReader(testPDF), nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "application/pdf")
+}
+
+func TestNewImageChunkTooLarge(t *testing.T) {
+	_, err := NewImageChunk(bytes.NewReader(testPNG), &AttachmentOptions{MaxSize: 8})
+
+	var tooLarge *AttachmentTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, int64(8), tooLarge.MaxSize)
+}
+
+func TestNewDocumentChunkFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	require.NoError(t, os.WriteFile(path, testPDF, 0o600))
+
+	chunk, err := NewDocumentChunkFromFile(path, nil)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(chunk.DocumentURL, "data:application/pdf;base64,"))
+	assert.Equal(t, "invoice.pdf", chunk.DocumentName)
+
+	_, err = NewDocumentChunkFromFile(path, &AttachmentOptions{MaxSize: 4})
+	var tooLarge *AttachmentTooLargeError
+	assert.ErrorAs(t, err, &tooLarge)
+}
+
+func TestNewDocumentChunkRejectsOtherTypes(t *testing.T) {
+	_, err := NewDocumentChunk(strings.NewReader("just some text"), nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "text/plain")
+}
@@ -0,0 +1,202 @@
+package mistral
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// TimestampGranularity is the level of detail of the timestamps returned with a transcription.
+type TimestampGranularity string
+
+const (
+	// TimestampGranularitySegment returns start and end timestamps for each segment.
+	TimestampGranularitySegment TimestampGranularity = "segment"
+)
+
+// TranscriptionRequest represents a request to transcribe an audio file. Exactly one of
+// File, FileURL or FileID must be set.
+type TranscriptionRequest struct {
+	// Model is the ID of the transcription model to use (e.g., "voxtral-mini-latest").
+	Model string
+
+	// File is an io.Reader providing the audio to upload.
+	File io.Reader
+
+	// Filename is the name of the uploaded audio file, including its extension
+	// (e.g., "call.mp3"). Only used with File.
+	Filename string
+
+	// FileURL is the URL of an audio file to download and transcribe.
+	FileURL string
+
+	// FileID is the ID of an audio file previously uploaded with UploadFile.
+	FileID string
+
+	// Language is the language of the audio as an ISO 639-1 code (e.g., "en"). Setting it
+	// improves accuracy; if empty, the language is detected automatically.
+	Language string
+
+	// Temperature controls randomness in the transcription. If nil, the API default is used.
+	Temperature *float64
+
+	// TimestampGranularities requests timestamps at the given levels of detail.
+	// Use TimestampGranularitySegment to get Segments in the response.
+	TimestampGranularities []TimestampGranularity
+}
+
+// TranscriptionSegmentChunk is a segment of a transcription with its timestamps.
+type TranscriptionSegmentChunk struct {
+	// Type is the chunk type, typically "transcription_segment".
+	Type string `json:"type,omitempty"`
+
+	// Text is the transcribed text of the segment.
+	Text string `json:"text"`
+
+	// Start is the start of the segment, in seconds from the beginning of the audio.
+	Start float64 `json:"start"`
+
+	// End is the end of the segment, in seconds from the beginning of the audio.
+	End float64 `json:"end"`
+}
+
+// TranscriptionUsage represents usage statistics for a transcription request.
+type TranscriptionUsage struct {
+	Usage
+
+	// PromptAudioSeconds is the duration of the transcribed audio, in seconds.
+	PromptAudioSeconds *int `json:"prompt_audio_seconds,omitempty"`
+}
+
+// TranscriptionResponse represents a response from the transcription API.
+type TranscriptionResponse struct {
+	// Model is the model used for the transcription.
+	Model string `json:"model"`
+
+	// Text is the full transcribed text.
+	Text string `json:"text"`
+
+	// Language is the language of the audio, if known.
+	Language string `json:"language,omitempty"`
+
+	// Segments are the timestamped segments of the transcription. Only returned when
+	// TimestampGranularitySegment is requested.
+	Segments []TranscriptionSegmentChunk `json:"segments,omitempty"`
+
+	// Usage contains usage statistics for the request.
+	Usage TranscriptionUsage `json:"usage"`
+}
+
+// TranscriptionEventType identifies the kind of a transcription stream event.
+type TranscriptionEventType string
+
+const (
+	// TranscriptionEventLanguage is sent once the language of the audio is detected.
+	TranscriptionEventLanguage TranscriptionEventType = "transcription.language"
+
+	// TranscriptionEventTextDelta carries a chunk of transcribed text.
+	TranscriptionEventTextDelta TranscriptionEventType = "transcription.text.delta"
+
+	// TranscriptionEventSegment carries a completed, timestamped segment.
+	TranscriptionEventSegment TranscriptionEventType = "transcription.segment"
+
+	// TranscriptionEventDone is sent once with the complete transcription.
+	TranscriptionEventDone TranscriptionEventType = "transcription.done"
+)
+
+// TranscriptionEvent is implemented by all transcription stream events. Use a type switch
+// to access the fields of a specific kind of event.
+type TranscriptionEvent interface {
+	// EventType returns the kind of the event.
+	EventType() TranscriptionEventType
+}
+
+// decodeTranscriptionEvent decodes a server-sent event into its concrete event type. The
+// "type" field of the payload is used if the event has no name. Events of an unknown type
+// are skipped by returning nil, nil.
+func decodeTranscriptionEvent(eventType TranscriptionEventType, data []byte) (TranscriptionEvent, error) {
+	if eventType == "" {
+		var head struct {
+			Type TranscriptionEventType `json:"type"`
+		}
+		if err := json.Unmarshal(data, &head); err != nil {
+			return nil, err
+		}
+		eventType = head.Type
+	}
+
+	var event TranscriptionEvent
+	switch eventType {
+	case TranscriptionEventLanguage:
+		event = &TranscriptionLanguageEvent{}
+	case TranscriptionEventTextDelta:
+		event = &TranscriptionTextDeltaEvent{}
+	case TranscriptionEventSegment:
+		event = &TranscriptionSegmentEvent{}
+	case TranscriptionEventDone:
+		event = &TranscriptionDoneEvent{}
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// TranscriptionLanguageEvent is sent once the language of the audio is detected.
+type TranscriptionLanguageEvent struct {
+	// Type is the event type, always "transcription.language".
+	Type TranscriptionEventType `json:"type"`
+
+	// AudioLanguage is the detected language of the audio.
+	AudioLanguage string `json:"audio_language"`
+}
+
+// EventType returns TranscriptionEventLanguage.
+func (TranscriptionLanguageEvent) EventType() TranscriptionEventType {
+	return TranscriptionEventLanguage
+}
+
+// TranscriptionTextDeltaEvent carries a chunk of transcribed text.
+type TranscriptionTextDeltaEvent struct {
+	// Type is the event type, always "transcription.text.delta".
+	Type TranscriptionEventType `json:"type"`
+
+	// Text is the new chunk of text.
+	Text string `json:"text"`
+}
+
+// EventType returns TranscriptionEventTextDelta.
+func (TranscriptionTextDeltaEvent) EventType() TranscriptionEventType {
+	return TranscriptionEventTextDelta
+}
+
+// TranscriptionSegmentEvent carries a completed, timestamped segment.
+type TranscriptionSegmentEvent struct {
+	// Type is the event type, always "transcription.segment".
+	Type TranscriptionEventType `json:"type"`
+
+	// Text is the transcribed text of the segment.
+	Text string `json:"text"`
+
+	// Start is the start of the segment, in seconds from the beginning of the audio.
+	Start float64 `json:"start"`
+
+	// End is the end of the segment, in seconds from the beginning of the audio.
+	End float64 `json:"end"`
+}
+
+// EventType returns TranscriptionEventSegment.
+func (TranscriptionSegmentEvent) EventType() TranscriptionEventType { return TranscriptionEventSegment }
+
+// TranscriptionDoneEvent is sent once at the end of the stream with the complete transcription.
+type TranscriptionDoneEvent struct {
+	TranscriptionResponse
+
+	// Type is the event type, always "transcription.done".
+	Type TranscriptionEventType `json:"type"`
+}
+
+// EventType returns TranscriptionEventDone.
+func (TranscriptionDoneEvent) EventType() TranscriptionEventType { return TranscriptionEventDone }
@@ -0,0 +1,431 @@
+package mistral
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// BatchEndpoint is the API endpoint every request of a batch job is sent to.
+type BatchEndpoint string
+
+const (
+	// BatchEndpointChatCompletions runs each request as a chat completion.
+	BatchEndpointChatCompletions BatchEndpoint = "/v1/chat/completions"
+
+	// BatchEndpointEmbeddings runs each request as an embedding request.
+	BatchEndpointEmbeddings BatchEndpoint = "/v1/embeddings"
+
+	// BatchEndpointFIMCompletions runs each request as a fill-in-the-middle completion.
+	BatchEndpointFIMCompletions BatchEndpoint = "/v1/fim/completions"
+
+	// BatchEndpointModerations runs each request as a text moderation request.
+	BatchEndpointModerations BatchEndpoint = "/v1/moderations"
+
+	// BatchEndpointChatModerations runs each request as a chat moderation request.
+	BatchEndpointChatModerations BatchEndpoint = "/v1/chat/moderations"
+)
+
+// BatchJobStatus is the state of a batch job.
+type BatchJobStatus string
+
+const (
+	// BatchJobStatusQueued indicates the job is waiting to be processed.
+	BatchJobStatusQueued BatchJobStatus = "QUEUED"
+
+	// BatchJobStatusRunning indicates the requests are being processed.
+	BatchJobStatusRunning BatchJobStatus = "RUNNING"
+
+	// BatchJobStatusSuccess indicates all requests were processed. Individual requests
+	// may still have failed; see FailedRequests and ErrorFile.
+	BatchJobStatusSuccess BatchJobStatus = "SUCCESS"
+
+	// BatchJobStatusFailed indicates the job failed.
+	BatchJobStatusFailed BatchJobStatus = "FAILED"
+
+	// BatchJobStatusTimeoutExceeded indicates the job did not finish within its timeout.
+	BatchJobStatusTimeoutExceeded BatchJobStatus = "TIMEOUT_EXCEEDED"
+
+	// BatchJobStatusCancellationRequested indicates a cancellation is in progress.
+	BatchJobStatusCancellationRequested BatchJobStatus = "CANCELLATION_REQUESTED"
+
+	// BatchJobStatusCancelled indicates the job was cancelled.
+	BatchJobStatusCancelled BatchJobStatus = "CANCELLED"
+)
+
+// IsTerminal reports whether a job in this state will not change anymore.
+func (s BatchJobStatus) IsTerminal() bool {
+	switch s {
+	case BatchJobStatusSuccess, BatchJobStatusFailed, BatchJobStatusTimeoutExceeded, BatchJobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// BatchJobRequest represents a request to create a batch job.
+type BatchJobRequest struct {
+	// InputFiles are the IDs of the JSONL files containing the requests. Files must be
+	// uploaded with FilePurposeBatch.
+	InputFiles []string `json:"input_files"`
+
+	// Endpoint is the API endpoint the requests are sent to.
+	Endpoint BatchEndpoint `json:"endpoint"`
+
+	// Model is the model used for every request. Either Model or AgentID must be set.
+	Model string `json:"model,omitempty"`
+
+	// AgentID is the agent used for every request. Either Model or AgentID must be set.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// Metadata contains custom key-value pairs attached to the job.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// TimeoutHours is the maximum duration of the job. If 0, the API default of 24 hours is used.
+	TimeoutHours int `json:"timeout_hours,omitempty"`
+}
+
+// BatchError describes an error that occurred while processing a batch job.
+type BatchError struct {
+	// Message is the error message.
+	Message string `json:"message"`
+
+	// Count is the number of times the error occurred.
+	Count int `json:"count"`
+}
+
+// BatchJob represents a batch job.
+type BatchJob struct {
+	// ID is the unique identifier of the job.
+	ID string `json:"id"`
+
+	// Object is the object type, typically "batch".
+	Object string `json:"object"`
+
+	// InputFiles are the IDs of the input files.
+	InputFiles []string `json:"input_files"`
+
+	// Metadata contains the custom key-value pairs attached to the job.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	// Endpoint is the API endpoint the requests are sent to.
+	Endpoint BatchEndpoint `json:"endpoint"`
+
+	// Model is the model used for every request, if set.
+	Model string `json:"model,omitempty"`
+
+	// AgentID is the agent used for every request, if set.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// OutputFile is the ID of the file containing the successful responses, set once
+	// results are available. Download it with DownloadFile.
+	OutputFile string `json:"output_file,omitempty"`
+
+	// ErrorFile is the ID of the file containing the failed requests, if any.
+	ErrorFile string `json:"error_file,omitempty"`
+
+	// Errors are the errors that occurred while processing the job.
+	Errors []BatchError `json:"errors"`
+
+	// Status is the current state of the job.
+	Status BatchJobStatus `json:"status"`
+
+	// CreatedAt is a Unix timestamp of when the job was created.
+	CreatedAt int64 `json:"created_at"`
+
+	// TotalRequests is the number of requests in the input files.
+	TotalRequests int `json:"total_requests"`
+
+	// CompletedRequests is the number of requests processed so far.
+	CompletedRequests int `json:"completed_requests"`
+
+	// SucceededRequests is the number of requests that succeeded.
+	SucceededRequests int `json:"succeeded_requests"`
+
+	// FailedRequests is the number of requests that failed.
+	FailedRequests int `json:"failed_requests"`
+
+	// StartedAt is a Unix timestamp of when processing started, if it has.
+	StartedAt *int64 `json:"started_at,omitempty"`
+
+	// CompletedAt is a Unix timestamp of when processing finished, if it has.
+	CompletedAt *int64 `json:"completed_at,omitempty"`
+}
+
+// BatchJobList represents a paginated list of batch jobs.
+type BatchJobList struct {
+	// Object is the object type, typically "list".
+	Object string `json:"object"`
+
+	// Data contains the batch jobs.
+	Data []BatchJob `json:"data"`
+
+	// Total is the total number of jobs matching the filters.
+	Total int `json:"total"`
+}
+
+// ListBatchJobsParams represents optional parameters for filtering and paginating
+// batch job lists. All fields are optional; omit them or use zero values to use defaults.
+type ListBatchJobsParams struct {
+	// Page is the page number to retrieve (0-indexed). If 0, returns the first page.
+	Page int
+
+	// PageSize is the number of jobs to return per page. If 0, uses the API's default page size.
+	PageSize int
+
+	// Model filters jobs by model.
+	Model string
+
+	// AgentID filters jobs by agent.
+	AgentID string
+
+	// CreatedAfter filters out jobs created before this time. Ignored if zero.
+	CreatedAfter time.Time
+
+	// CreatedByMe restricts the results to jobs created by the API caller.
+	CreatedByMe bool
+
+	// Status filters jobs by state. Jobs matching any of the given states are returned.
+	Status []BatchJobStatus
+}
+
+// batchRequestLine is a single line of a batch input file.
+type batchRequestLine struct {
+	CustomID string      `json:"custom_id"`
+	Body     interface{} `json:"body"`
+}
+
+// BatchWriter writes requests to a batch input file in the JSONL format expected by
+// CreateBatchJob, one request per line. Each request is identified by a custom ID, which
+// must be unique within the file and is used to match results back to requests.
+//
+// A batch job sends all its requests to a single endpoint, so a BatchWriter only accepts
+// requests of one kind: the first request written determines the endpoint, available
+// from Endpoint, and requests of any other kind are rejected.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	w := mistral.NewBatchWriter(&buf)
+//	for i, text := range texts {
+//	    err := w.WriteChatCompletion(fmt.Sprintf("review-%d", i), &mistral.ChatCompletionRequest{
+//	        Model:    "mistral-small-latest",
+//	        Messages: []mistral.ChatMessage{{Role: mistral.RoleUser, Content: mistral.TextContent(text)}},
+//	    })
+//	    if err != nil {
+//	        return err
+//	    }
+//	}
+//	file, err := client.UploadFile(ctx, &mistral.UploadFileRequest{
+//	    File:     &buf,
+//	    Filename: "requests.jsonl",
+//	    Purpose:  mistral.FilePurposeBatch,
+//	})
+type BatchWriter struct {
+	enc      *json.Encoder
+	endpoint BatchEndpoint
+	ids      map[string]struct{}
+}
+
+// NewBatchWriter returns a BatchWriter that writes JSONL lines to w.
+func NewBatchWriter(w io.Writer) *BatchWriter {
+	return &BatchWriter{
+		enc: json.NewEncoder(w),
+		ids: make(map[string]struct{}),
+	}
+}
+
+// WriteChatCompletion writes a chat completion request identified by customID.
+// Streaming requests are rejected, since batch results are never streamed.
+func (b *BatchWriter) WriteChatCompletion(customID string, req *ChatCompletionRequest) error {
+	if req.Stream {
+		return errors.New("batch requests cannot be streamed")
+	}
+	return b.write(BatchEndpointChatCompletions, customID, req)
+}
+
+// WriteEmbedding writes an embedding request identified by customID.
+func (b *BatchWriter) WriteEmbedding(customID string, req *EmbeddingRequest) error {
+	return b.write(BatchEndpointEmbeddings, customID, req)
+}
+
+// WriteFIMCompletion writes a fill-in-the-middle completion request identified by customID.
+// Streaming requests are rejected, since batch results are never streamed.
+func (b *BatchWriter) WriteFIMCompletion(customID string, req *FIMCompletionRequest) error {
+	if req.Stream {
+		return errors.New("batch requests cannot be streamed")
+	}
+	return b.write(BatchEndpointFIMCompletions, customID, req)
+}
+
+// Endpoint returns the endpoint of the requests written so far, to be used as the
+// Endpoint of the BatchJobRequest. It is empty until the first request is written.
+func (b *BatchWriter) Endpoint() BatchEndpoint {
+	return b.endpoint
+}
+
+// Len returns the number of requests written so far.
+func (b *BatchWriter) Len() int {
+	return len(b.ids)
+}
+
+// write validates the custom ID and endpoint, then writes the request as a single line.
+func (b *BatchWriter) write(endpoint BatchEndpoint, customID string, body interface{}) error {
+	if customID == "" {
+		return errors.New("batch request custom ID is required")
+	}
+	if _, ok := b.ids[customID]; ok {
+		return fmt.Errorf("duplicate batch request custom ID %q", customID)
+	}
+	if b.endpoint != "" && b.endpoint != endpoint {
+		return fmt.Errorf("batch already contains %s requests, cannot add a %s request", b.endpoint, endpoint)
+	}
+
+	if err := b.enc.Encode(batchRequestLine{CustomID: customID, Body: body}); err != nil {
+		return fmt.Errorf("failed to write batch request %q: %w", customID, err)
+	}
+
+	b.endpoint = endpoint
+	b.ids[customID] = struct{}{}
+	return nil
+}
+
+// BatchResultResponse is the HTTP response returned for a single batch request.
+type BatchResultResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"status_code"`
+
+	// Body is the raw response body. Use the typed accessors of BatchResult to decode it.
+	Body json.RawMessage `json:"body"`
+}
+
+// BatchResult is a single line of a batch output or error file.
+type BatchResult struct {
+	// ID is the unique identifier of the result.
+	ID string `json:"id"`
+
+	// CustomID is the custom ID of the request this result belongs to.
+	CustomID string `json:"custom_id"`
+
+	// Response is the response to the request, if one was produced.
+	Response *BatchResultResponse `json:"response,omitempty"`
+
+	// Error is the raw error reported for the request, if any. Use Err to decode it.
+	Error json.RawMessage `json:"error,omitempty"`
+}
+
+// Err returns the error of the request as an *APIError, or nil if the request succeeded.
+// A request failed if the result carries an error or if its response has a non-2xx status.
+// If the result has neither a response nor an error, the returned *APIError has a
+// StatusCode of 0.
+func (r *BatchResult) Err() error {
+	statusCode := 0
+	if r.Response != nil {
+		statusCode = r.Response.StatusCode
+	}
+
+	if len(r.Error) > 0 && string(r.Error) != "null" {
+		var message string
+		if err := json.Unmarshal(r.Error, &message); err == nil {
+			return &APIError{StatusCode: statusCode, Message: message}
+		}
+		return parseAPIError(statusCode, r.Error)
+	}
+	if statusCode < 200 || statusCode >= 300 {
+		if r.Response == nil {
+			return &APIError{Message: fmt.Sprintf("batch request %q has no response", r.CustomID)}
+		}
+		return parseAPIError(statusCode, r.Response.Body)
+	}
+	return nil
+}
+
+// ChatCompletion decodes the response of a chat completion request.
+// It returns the request's error instead if the request failed.
+func (r *BatchResult) ChatCompletion() (*ChatCompletionResponse, error) {
+	var resp ChatCompletionResponse
+	if err := r.decode(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Embedding decodes the response of an embedding request.
+// It returns the request's error instead if the request failed.
+func (r *BatchResult) Embedding() (*EmbeddingResponse, error) {
+	var resp EmbeddingResponse
+	if err := r.decode(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// FIMCompletion decodes the response of a fill-in-the-middle completion request.
+// It returns the request's error instead if the request failed.
+func (r *BatchResult) FIMCompletion() (*ChatCompletionResponse, error) {
+	return r.ChatCompletion()
+}
+
+// decode unmarshals the response body into v.
+func (r *BatchResult) decode(v interface{}) error {
+	if err := r.Err(); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(r.Response.Body, v); err != nil {
+		return fmt.Errorf("failed to decode batch result %q: %w", r.CustomID, err)
+	}
+	return nil
+}
+
+// BatchResultReader reads the results of a batch job from an output or error file,
+// as downloaded with DownloadFile.
+//
+// Example:
+//
+//	body, err := client.DownloadFile(ctx, job.OutputFile)
+//	if err != nil {
+//	    return err
+//	}
+//	defer body.Close()
+//
+//	results, err := mistral.NewBatchResultReader(body).ReadAll()
+//	if err != nil {
+//	    return err
+//	}
+//	resp, err := results["review-0"].ChatCompletion()
+type BatchResultReader struct {
+	dec *json.Decoder
+}
+
+// NewBatchResultReader returns a BatchResultReader that reads JSONL results from r.
+func NewBatchResultReader(r io.Reader) *BatchResultReader {
+	return &BatchResultReader{dec: json.NewDecoder(r)}
+}
+
+// Next returns the next result. It returns io.EOF when there are no more results.
+func (r *BatchResultReader) Next() (*BatchResult, error) {
+	var result BatchResult
+	if err := r.dec.Decode(&result); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to decode batch result: %w", err)
+	}
+	return &result, nil
+}
+
+// ReadAll reads all remaining results and returns them keyed by custom ID.
+func (r *BatchResultReader) ReadAll() (map[string]*BatchResult, error) {
+	results := make(map[string]*BatchResult)
+	for {
+		result, err := r.Next()
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		results[result.CustomID] = result
+	}
+}
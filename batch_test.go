@@ -0,0 +1,98 @@
+package mistral
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewBatchWriter(&buf)
+
+	require.NoError(t, w.WriteChatCompletion("a", &ChatCompletionRequest{
+		Model:    "mistral-small-latest",
+		Messages: []ChatMessage{{Role: RoleUser, Content: TextContent("Hello")}},
+	}))
+	require.NoError(t, w.WriteChatCompletion("b", &ChatCompletionRequest{
+		Model:    "mistral-small-latest",
+		Messages: []ChatMessage{{Role: RoleUser, Content: TextContent("Bye")}},
+	}))
+
+	assert.Equal(t, BatchEndpointChatCompletions, w.Endpoint())
+	assert.Equal(t, 2, w.Len())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"custom_id": "a", "body": {"model": "mistral-small-latest", "messages": [{"role": "user", "content": "Hello"}]}}`, lines[0])
+	assert.JSONEq(t, `{"custom_id": "b", "body": {"model": "mistral-small-latest", "messages": [{"role": "user", "content": "Bye"}]}}`, lines[1])
+}
+
+func TestBatchWriterRejectsInvalidRequests(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewBatchWriter(&buf)
+
+	require.NoError(t, w.WriteEmbedding("a", &EmbeddingRequest{Model: "mistral-embed", Input: []string{"x"}}))
+
+	assert.EqualError(t, w.WriteEmbedding("a", &EmbeddingRequest{Model: "mistral-embed", Input: []string{"y"}}),
+		`duplicate batch request custom ID "a"`)
+	assert.Error(t, w.WriteEmbedding("", &EmbeddingRequest{Model: "mistral-embed", Input: []string{"y"}}))
+	assert.Error(t, w.WriteFIMCompletion("b", &FIMCompletionRequest{Model: "codestral-latest", Prompt: "def"}))
+	assert.Error(t, w.WriteChatCompletion("c", &ChatCompletionRequest{Model: "mistral-small-latest", Stream: true}))
+
+	assert.Equal(t, 1, w.Len())
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+}
+
+func TestBatchResultReader(t *testing.T) {
+	input := `{"id": "r-1", "custom_id": "a", "response": {"status_code": 200, "body": {"id": "cmpl-1", "object": "chat.completion", "model": "mistral-small-latest", "choices": [{"index": 0, "message": {"role": "assistant", "content": "Hi"}, "finish_reason": "stop"}], "usage": {"prompt_tokens": 1, "completion_tokens": 1, "total_tokens": 2}}}, "error": null}
+{"id": "r-2", "custom_id": "b", "response": {"status_code": 400, "body": {"message": "Invalid model", "type": "invalid_request_error"}}, "error": null}
+{"id": "r-3", "custom_id": "c", "error": {"message": "Request timed out"}}
+{"id": "r-4", "custom_id": "d"}
+{"id": "r-5", "custom_id": "e", "error": "Internal error"}
+`
+	results, err := NewBatchResultReader(strings.NewReader(input)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	resp, err := results["a"].ChatCompletion()
+	require.NoError(t, err)
+	assert.Equal(t, "cmpl-1", resp.ID)
+	assert.Equal(t, "Hi", resp.Choices[0].Message.Content.Text())
+
+	_, err = results["b"].ChatCompletion()
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 400, apiErr.StatusCode)
+	assert.Equal(t, "Invalid model", apiErr.Message)
+
+	_, err = results["c"].ChatCompletion()
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "Request timed out", apiErr.Message)
+
+	_, err = results["d"].ChatCompletion()
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 0, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Message, "no response")
+
+	_, err = results["e"].ChatCompletion()
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "Internal error", apiErr.Message)
+}
+
+func TestBatchResultReaderNext(t *testing.T) {
+	r := NewBatchResultReader(strings.NewReader(`{"custom_id": "a", "response": {"status_code": 200, "body": {"data": [{"embedding": [0.5], "index": 0}]}}}`))
+
+	result, err := r.Next()
+	require.NoError(t, err)
+	resp, err := result.Embedding()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.5}, resp.Data[0].Embedding)
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
@@ -59,6 +59,10 @@ type ChatCompletionRequest struct {
 	// SafePrompt indicates whether to inject a safety prompt before all conversations. Default is false.
 	SafePrompt bool `json:"safe_prompt,omitempty"`
 
+	// PromptMode selects the system prompt of reasoning models such as "magistral-medium-latest"
+	// (see PromptMode). If empty, the field is omitted and the server default applies.
+	PromptMode PromptMode `json:"prompt_mode,omitempty"`
+
 	// N is how many chat completion choices to generate for each input message.
 	// Note: N>1 may consume significantly more tokens.
 	N *int `json:"n,omitempty"`
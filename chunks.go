@@ -0,0 +1,475 @@
+package mistral
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ChunkType identifies the kind of a content chunk.
+type ChunkType string
+
+const (
+	// ChunkTypeText identifies a TextChunk.
+	ChunkTypeText ChunkType = "text"
+
+	// ChunkTypeImageURL identifies an ImageURLChunk.
+	ChunkTypeImageURL ChunkType = "image_url"
+
+	// ChunkTypeDocumentURL identifies a DocumentURLChunk.
+	ChunkTypeDocumentURL ChunkType = "document_url"
+
+	// ChunkTypeFile identifies a FileChunk.
+	ChunkTypeFile ChunkType = "file"
+
+	// ChunkTypeReference identifies a ReferenceChunk.
+	ChunkTypeReference ChunkType = "reference"
+
+	// ChunkTypeThinking identifies a ThinkChunk.
+	ChunkTypeThinking ChunkType = "thinking"
+
+	// ChunkTypeInputAudio identifies an AudioChunk.
+	ChunkTypeInputAudio ChunkType = "input_audio"
+
+	// ChunkTypeToolReference identifies a ToolReferenceChunk.
+	ChunkTypeToolReference ChunkType = "tool_reference"
+)
+
+// ContentChunk is implemented by all content chunk types. Use a type switch to access
+// the fields of a specific kind of chunk.
+type ContentChunk interface {
+	// ChunkType returns the kind of the chunk.
+	ChunkType() ChunkType
+}
+
+// decodeContentChunk decodes a content chunk into its concrete type, based on its "type"
+// field. Chunks of an unknown type are skipped by returning nil, nil.
+func decodeContentChunk(data []byte) (ContentChunk, error) {
+	var head struct {
+		Type ChunkType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+
+	var chunk ContentChunk
+	switch head.Type {
+	case ChunkTypeText:
+		chunk = &TextChunk{}
+	case ChunkTypeImageURL:
+		chunk = &ImageURLChunk{}
+	case ChunkTypeDocumentURL:
+		chunk = &DocumentURLChunk{}
+	case ChunkTypeFile:
+		chunk = &FileChunk{}
+	case ChunkTypeReference:
+		chunk = &ReferenceChunk{}
+	case ChunkTypeThinking:
+		chunk = &ThinkChunk{}
+	case ChunkTypeInputAudio:
+		chunk = &AudioChunk{}
+	case ChunkTypeToolReference:
+		chunk = &ToolReferenceChunk{}
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, chunk); err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// decodeContentChunks decodes an array of content chunks, skipping chunks of an unknown type.
+func decodeContentChunks(data []byte) ([]ContentChunk, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	chunks := make([]ContentChunk, 0, len(raw))
+	for _, r := range raw {
+		chunk, err := decodeContentChunk(r)
+		if err != nil {
+			return nil, err
+		}
+		if chunk != nil {
+			chunks = append(chunks, chunk)
+		}
+	}
+	return chunks, nil
+}
+
+// Content is the content of a chat message: either plain text, or a list of chunks mixing
+// text with images, documents, audio and other kinds of content. Create it with TextContent
+// or ChunkContent. The zero value is empty content, encoded as null, while TextContent("")
+// is encoded as an empty string.
+//
+// When decoded from a response, chunks are pointers to their concrete types (e.g., *TextChunk).
+type Content struct {
+	text   string
+	chunks []ContentChunk
+
+	// isText is set for content created with TextContent, including empty text.
+	isText bool
+}
+
+// TextContent returns content made of plain text.
+func TextContent(text string) Content {
+	return Content{text: text, isText: true}
+}
+
+// ChunkContent returns content made of the given chunks.
+//
+// Example:
+//
+//	content := mistral.ChunkContent(
+//	    mistral.TextChunk{Text: "What is in this image?"},
+//	    mistral.ImageURLChunk{ImageURL: mistral.ImageURL{URL: "https://example.com/cat.png"}},
+//	)
+func ChunkContent(chunks ...ContentChunk) Content {
+	return Content{chunks: chunks}
+}
+
+// IsText reports whether the content is plain text rather than a list of chunks.
+func (c Content) IsText() bool {
+	return c.chunks == nil
+}
+
+// Chunks returns the chunks of the content. Plain text is returned as a single TextChunk,
+// and empty content as nil.
+func (c Content) Chunks() []ContentChunk {
+	if c.chunks == nil && c.text != "" {
+		return []ContentChunk{TextChunk{Text: c.text}}
+	}
+	return c.chunks
+}
+
+// Text returns the text of the content: the plain text, or the text chunks concatenated
+// in order. Other kinds of chunks are ignored, including reasoning traces, so for reasoning
+// models this is the final answer. Use Thinking to get the reasoning trace.
+func (c Content) Text() string {
+	if c.chunks == nil {
+		return c.text
+	}
+
+	var b strings.Builder
+	for _, chunk := range c.chunks {
+		switch chunk := chunk.(type) {
+		case TextChunk:
+			b.WriteString(chunk.Text)
+		case *TextChunk:
+			b.WriteString(chunk.Text)
+		}
+	}
+	return b.String()
+}
+
+// Thinking returns the reasoning trace of the content: the text of its ThinkChunk chunks
+// concatenated in order, without the answer. It is empty unless the model reasons, e.g.
+// with PromptModeReasoning. Use Text to get the answer.
+//
+// Example:
+//
+//	msg := resp.Choices[0].Message
+//	log.Printf("reasoning: %s", msg.Content.Thinking())
+//	log.Printf("answer: %s", msg.Content.Text())
+func (c Content) Thinking() string {
+	var b strings.Builder
+	for _, chunk := range c.chunks {
+		switch chunk := chunk.(type) {
+		case ThinkChunk:
+			b.WriteString(chunk.Text())
+		case *ThinkChunk:
+			b.WriteString(chunk.Text())
+		}
+	}
+	return b.String()
+}
+
+// MarshalJSON encodes the content as a string or an array of chunks.
+func (c Content) MarshalJSON() ([]byte, error) {
+	if c.chunks != nil {
+		return json.Marshal(c.chunks)
+	}
+	if !c.isText {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.text)
+}
+
+// UnmarshalJSON decodes the content from a string, an array of chunks, or null.
+func (c *Content) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = Content{}
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*c = TextContent(text)
+		return nil
+	}
+
+	chunks, err := decodeContentChunks(data)
+	if err != nil {
+		return err
+	}
+	*c = Content{chunks: chunks}
+	return nil
+}
+
+// TextChunk is a piece of text.
+type TextChunk struct {
+	// Type is the chunk type. It is always "text" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// Text is the text of the chunk.
+	Text string `json:"text"`
+}
+
+// ChunkType returns ChunkTypeText.
+func (TextChunk) ChunkType() ChunkType { return ChunkTypeText }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c TextChunk) MarshalJSON() ([]byte, error) {
+	type alias TextChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeText
+	}
+	return json.Marshal(a)
+}
+
+// ImageURL is the location of an image, either a public URL or a base64 data URL.
+type ImageURL struct {
+	// URL is the URL of the image.
+	URL string `json:"url"`
+
+	// Detail is the level of detail the image is processed with ("low", "high" or "auto").
+	Detail string `json:"detail,omitempty"`
+}
+
+// UnmarshalJSON decodes the image URL from either an object or a plain URL string.
+func (u *ImageURL) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*u = ImageURL{URL: s}
+		return nil
+	}
+
+	type alias ImageURL
+	var a alias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+	*u = ImageURL(a)
+	return nil
+}
+
+// ImageURLChunk is an image referenced by URL.
+type ImageURLChunk struct {
+	// Type is the chunk type. It is always "image_url" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// ImageURL is the location of the image.
+	ImageURL ImageURL `json:"image_url"`
+}
+
+// ChunkType returns ChunkTypeImageURL.
+func (ImageURLChunk) ChunkType() ChunkType { return ChunkTypeImageURL }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c ImageURLChunk) MarshalJSON() ([]byte, error) {
+	type alias ImageURLChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeImageURL
+	}
+	return json.Marshal(a)
+}
+
+// DocumentURLChunk is a document, such as a PDF, referenced by URL.
+type DocumentURLChunk struct {
+	// Type is the chunk type. It is always "document_url" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// DocumentURL is the URL of the document, either a public URL or a base64 data URL.
+	DocumentURL string `json:"document_url"`
+
+	// DocumentName is an optional file name for the document.
+	DocumentName string `json:"document_name,omitempty"`
+}
+
+// ChunkType returns ChunkTypeDocumentURL.
+func (DocumentURLChunk) ChunkType() ChunkType { return ChunkTypeDocumentURL }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c DocumentURLChunk) MarshalJSON() ([]byte, error) {
+	type alias DocumentURLChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeDocumentURL
+	}
+	return json.Marshal(a)
+}
+
+// FileChunk is a file previously uploaded with UploadFile, referenced by its ID.
+type FileChunk struct {
+	// Type is the chunk type. It is always "file" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// FileID is the ID of the uploaded file.
+	FileID string `json:"file_id"`
+}
+
+// ChunkType returns ChunkTypeFile.
+func (FileChunk) ChunkType() ChunkType { return ChunkTypeFile }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c FileChunk) MarshalJSON() ([]byte, error) {
+	type alias FileChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeFile
+	}
+	return json.Marshal(a)
+}
+
+// ReferenceChunk cites the sources, such as tool results, the surrounding text is based on.
+type ReferenceChunk struct {
+	// Type is the chunk type. It is always "reference" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// ReferenceIDs are the IDs of the cited references.
+	ReferenceIDs []int `json:"reference_ids"`
+}
+
+// ChunkType returns ChunkTypeReference.
+func (ReferenceChunk) ChunkType() ChunkType { return ChunkTypeReference }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c ReferenceChunk) MarshalJSON() ([]byte, error) {
+	type alias ReferenceChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeReference
+	}
+	return json.Marshal(a)
+}
+
+// ThinkChunk is the reasoning trace produced by a reasoning model before its answer.
+type ThinkChunk struct {
+	// Type is the chunk type. It is always "thinking" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// Thinking is the content of the reasoning trace, made of TextChunk and ReferenceChunk.
+	Thinking []ContentChunk `json:"thinking"`
+
+	// Closed indicates whether the reasoning trace is complete. If nil, it is assumed to be.
+	Closed *bool `json:"closed,omitempty"`
+}
+
+// ChunkType returns ChunkTypeThinking.
+func (ThinkChunk) ChunkType() ChunkType { return ChunkTypeThinking }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c ThinkChunk) MarshalJSON() ([]byte, error) {
+	type alias ThinkChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeThinking
+	}
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON decodes the chunk, decoding Thinking into concrete chunk types.
+func (c *ThinkChunk) UnmarshalJSON(data []byte) error {
+	type alias ThinkChunk
+	var a struct {
+		alias
+		Thinking json.RawMessage `json:"thinking"`
+	}
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	*c = ThinkChunk(a.alias)
+	if len(a.Thinking) > 0 && string(a.Thinking) != "null" {
+		thinking, err := decodeContentChunks(a.Thinking)
+		if err != nil {
+			return err
+		}
+		c.Thinking = thinking
+	}
+	return nil
+}
+
+// Text returns the text of the reasoning trace, ignoring references.
+func (c ThinkChunk) Text() string {
+	return ChunkContent(c.Thinking...).Text()
+}
+
+// AudioChunk is an audio clip.
+type AudioChunk struct {
+	// Type is the chunk type. It is always "input_audio" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// InputAudio is the audio, either a public URL or base64-encoded audio data.
+	InputAudio string `json:"input_audio"`
+}
+
+// ChunkType returns ChunkTypeInputAudio.
+func (AudioChunk) ChunkType() ChunkType { return ChunkTypeInputAudio }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c AudioChunk) MarshalJSON() ([]byte, error) {
+	type alias AudioChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeInputAudio
+	}
+	return json.Marshal(a)
+}
+
+// ToolReferenceChunk cites a source found by a built-in connector, such as a web page
+// returned by web search.
+type ToolReferenceChunk struct {
+	// Type is the chunk type. It is always "tool_reference" and is filled in automatically
+	// when the chunk is encoded.
+	Type ChunkType `json:"type,omitempty"`
+
+	// Tool is the connector that found the source.
+	Tool BuiltInConnector `json:"tool"`
+
+	// Title is the title of the source.
+	Title string `json:"title"`
+
+	// URL is the URL of the source, if any.
+	URL string `json:"url,omitempty"`
+
+	// Favicon is the URL of the source's favicon, if any.
+	Favicon string `json:"favicon,omitempty"`
+
+	// Description is a short description of the source, if any.
+	Description string `json:"description,omitempty"`
+}
+
+// ChunkType returns ChunkTypeToolReference.
+func (ToolReferenceChunk) ChunkType() ChunkType { return ChunkTypeToolReference }
+
+// MarshalJSON encodes the chunk, setting the "type" field if it is empty.
+func (c ToolReferenceChunk) MarshalJSON() ([]byte, error) {
+	type alias ToolReferenceChunk
+	a := alias(c)
+	if a.Type == "" {
+		a.Type = ChunkTypeToolReference
+	}
+	return json.Marshal(a)
+}
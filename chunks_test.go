@@ -0,0 +1,121 @@
+package mistral
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentChunkMarshalSetsType(t *testing.T) {
+	chunks := []ContentChunk{
+		ImageURLChunk{ImageURL: ImageURL{URL: "https://example.com/a.png", Detail: "high"}},
+		DocumentURLChunk{DocumentURL: "https://example.com/a.pdf", DocumentName: "a.pdf"},
+		FileChunk{FileID: "file-1"},
+	}
+
+	data, err := json.Marshal(chunks)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"type": "image_url", "image_url": {"url": "https://example.com/a.png", "detail": "high"}},
+		{"type": "document_url", "document_url": "https://example.com/a.pdf", "document_name": "a.pdf"},
+		{"type": "file", "file_id": "file-1"}
+	]`, string(data))
+}
+
+func TestImageURLUnmarshalAcceptsString(t *testing.T) {
+	var chunk ImageURLChunk
+	require.NoError(t, json.Unmarshal([]byte(`{"type": "image_url", "image_url": "https://example.com/a.png"}`), &chunk))
+	assert.Equal(t, ImageURL{URL: "https://example.com/a.png"}, chunk.ImageURL)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"type": "image_url", "image_url": {"url": "https://example.com/b.png", "detail": "low"}}`), &chunk))
+	assert.Equal(t, ImageURL{URL: "https://example.com/b.png", Detail: "low"}, chunk.ImageURL)
+}
+
+func TestContentMarshal(t *testing.T) {
+	msgs := []ChatMessage{
+		{Role: RoleUser, Content: TextContent("Hello")},
+		{Role: RoleUser, Content: ChunkContent(
+			TextChunk{Text: "What is this?"},
+			ImageURLChunk{ImageURL: ImageURL{URL: "https://example.com/a.png"}},
+			AudioChunk{InputAudio: "https://example.com/a.mp3"},
+		)},
+		{Role: RoleAssistant, ToolCalls: []ToolCall{{ID: "call-1", Type: "function", Function: FunctionCall{Name: "f", Arguments: "{}"}}}},
+		{Role: RoleTool, Content: TextContent(""), ToolCallID: "call-1"},
+	}
+
+	data, err := json.Marshal(msgs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"role": "user", "content": "Hello"},
+		{"role": "user", "content": [
+			{"type": "text", "text": "What is this?"},
+			{"type": "image_url", "image_url": {"url": "https://example.com/a.png"}},
+			{"type": "input_audio", "input_audio": "https://example.com/a.mp3"}
+		]},
+		{"role": "assistant", "content": null, "tool_calls": [{"id": "call-1", "type": "function", "function": {"name": "f", "arguments": "{}"}}]},
+		{"role": "tool", "content": "", "tool_call_id": "call-1"}
+	]`, string(data))
+}
+
+func TestContentUnmarshal(t *testing.T) {
+	var msg ChatMessage
+	require.NoError(t, json.Unmarshal([]byte(`{"role": "assistant", "content": "Paris"}`), &msg))
+	assert.True(t, msg.Content.IsText())
+	assert.Equal(t, "Paris", msg.Content.Text())
+	assert.Equal(t, []ContentChunk{TextChunk{Text: "Paris"}}, msg.Content.Chunks())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"role": "assistant", "content": [
+		{"type": "thinking", "thinking": [{"type": "text", "text": "The user wants"}, {"type": "reference", "reference_ids": [1]}], "closed": true},
+		{"type": "text", "text": "Paris is "},
+		{"type": "reference", "reference_ids": [1, 2]},
+		{"type": "tool_reference", "tool": "web_search", "title": "Paris", "url": "https://example.com/paris"},
+		{"type": "unknown_chunk"},
+		{"type": "text", "text": "the capital."}
+	]}`), &msg))
+
+	assert.False(t, msg.Content.IsText())
+	assert.Equal(t, "Paris is the capital.", msg.Content.Text())
+
+	chunks := msg.Content.Chunks()
+	require.Len(t, chunks, 5)
+
+	think, ok := chunks[0].(*ThinkChunk)
+	require.True(t, ok)
+	assert.Equal(t, "The user wants", think.Text())
+	assert.Equal(t, &ReferenceChunk{Type: ChunkTypeReference, ReferenceIDs: []int{1}}, think.Thinking[1])
+	require.NotNil(t, think.Closed)
+	assert.True(t, *think.Closed)
+
+	assert.Equal(t, &ReferenceChunk{Type: ChunkTypeReference, ReferenceIDs: []int{1, 2}}, chunks[2])
+	assert.Equal(t, &ToolReferenceChunk{
+		Type:  ChunkTypeToolReference,
+		Tool:  BuiltInConnectorWebSearch,
+		Title: "Paris",
+		URL:   "https://example.com/paris",
+	}, chunks[3])
+
+	require.NoError(t, json.Unmarshal([]byte(`{"role": "assistant", "content": null}`), &msg))
+	assert.Equal(t, "", msg.Content.Text())
+	assert.Nil(t, msg.Content.Chunks())
+}
+
+func TestContentRoundTrip(t *testing.T) {
+	content := ChunkContent(
+		TextChunk{Text: "Summarize"},
+		DocumentURLChunk{DocumentURL: "https://example.com/a.pdf"},
+		FileChunk{FileID: "file-1"},
+	)
+
+	data, err := json.Marshal(content)
+	require.NoError(t, err)
+
+	var decoded Content
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, []ContentChunk{
+		&TextChunk{Type: ChunkTypeText, Text: "Summarize"},
+		&DocumentURLChunk{Type: ChunkTypeDocumentURL, DocumentURL: "https://example.com/a.pdf"},
+		&FileChunk{Type: ChunkTypeFile, FileID: "file-1"},
+	}, decoded.Chunks())
+}
@@ -0,0 +1,62 @@
+package mistral
+
+// ClassificationRequest represents a request to classify raw text with a classifier model.
+type ClassificationRequest struct {
+	// Model is the ID of the classifier model to use, typically a model produced by a
+	// classifier fine-tuning job.
+	Model string `json:"model"`
+
+	// Input is the text to classify, either a string or a []string to classify several
+	// texts at once.
+	Input interface{} `json:"input"`
+}
+
+// ClassificationChat is a conversation to classify.
+type ClassificationChat struct {
+	// Messages are the messages of the conversation.
+	Messages []ChatMessage `json:"messages"`
+}
+
+// ChatClassificationRequest represents a request to classify conversations with a
+// classifier model.
+type ChatClassificationRequest struct {
+	// Model is the ID of the classifier model to use, typically a model produced by a
+	// classifier fine-tuning job.
+	Model string `json:"model"`
+
+	// Input is the conversation to classify, either a ClassificationChat or a
+	// []ClassificationChat to classify several conversations at once.
+	Input interface{} `json:"input"`
+}
+
+// ClassificationTargetResult contains the scores of a single classifier target.
+type ClassificationTargetResult struct {
+	// Scores maps each label of the target to its score.
+	Scores map[string]float64 `json:"scores"`
+}
+
+// Top returns the label with the highest score and its score. It returns an empty label
+// if there are no scores. Ties are broken by label name, so the result is deterministic.
+func (r ClassificationTargetResult) Top() (string, float64) {
+	var label string
+	var score float64
+	for l, s := range r.Scores {
+		if label == "" || s > score || (s == score && l < label) {
+			label, score = l, s
+		}
+	}
+	return label, score
+}
+
+// ClassificationResponse represents a response from the classification APIs.
+type ClassificationResponse struct {
+	// ID is a unique identifier for this classification request.
+	ID string `json:"id"`
+
+	// Model is the model used for classification.
+	Model string `json:"model"`
+
+	// Results contains one entry per input, in the same order as the inputs. Each entry
+	// maps a classifier target name to its result.
+	Results []map[string]ClassificationTargetResult `json:"results"`
+}
@@ -8,6 +8,7 @@
 //   - File management for fine-tuning and batch processing
 //   - Model information and management
 //   - Function/tool calling for building AI agents
+//   - Stateful conversations with models and agents
 //
 // # Getting Started
 //
@@ -20,7 +21,7 @@
 //	resp, err := client.CreateChatCompletion(ctx, &mistral.ChatCompletionRequest{
 //	    Model: "mistral-large-latest",
 //	    Messages: []mistral.ChatMessage{
-//	        {Role: mistral.RoleUser, Content: "Hello!"},
+//	        {Role: mistral.RoleUser, Content: mistral.TextContent("Hello!")},
 //	    },
 //	})
 //
@@ -64,17 +65,25 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	defaultBaseURL = "https://api.mistral.ai"
 	defaultTimeout = 60 * time.Second
+
+	// maxStreamLineSize is the largest single line accepted in a server-sent event stream.
+	maxStreamLineSize = 1024 * 1024
 )
 
 // Client represents a Mistral AI API client.
@@ -192,16 +201,60 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 //   - An *APIError containing the HTTP status code, error message, type, and code
 func (c *Client) handleErrorResponse(resp *http.Response) error {
 	body, _ := io.ReadAll(resp.Body)
+	return parseAPIError(resp.StatusCode, body)
+}
+
+// doMultipartRequest is an internal helper for endpoints taking multipart form data. It POSTs
+// the already encoded body to path and decodes the JSON response into result.
+//
+// Parameters:
+//   - ctx: Context for request cancellation
+//   - path: API endpoint path (e.g., "/v1/files")
+//   - contentType: The Content-Type of body, including the multipart boundary
+//   - body: The encoded form data
+//   - result: Pointer to the struct to decode the response into
+//
+// Returns:
+//   - An error if the request fails or the response cannot be decoded
+func (c *Client) doMultipartRequest(ctx context.Context, path, contentType string, body io.Reader, result interface{}) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return c.handleErrorResponse(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
 
+// parseAPIError decodes an error body into an APIError. If the body isn't a JSON error
+// object, the raw body is used as the message.
+func parseAPIError(statusCode int, body []byte) *APIError {
 	var apiError APIError
 	if err := json.Unmarshal(body, &apiError); err != nil {
 		return &APIError{
-			StatusCode: resp.StatusCode,
+			StatusCode: statusCode,
 			Message:    string(body),
 		}
 	}
 
-	apiError.StatusCode = resp.StatusCode
+	apiError.StatusCode = statusCode
 	return &apiError
 }
 
@@ -221,7 +274,7 @@ func (c *Client) handleErrorResponse(resp *http.Response) error {
 //	resp, err := client.CreateChatCompletion(ctx, &mistral.ChatCompletionRequest{
 //	    Model: "mistral-large-latest",
 //	    Messages: []mistral.ChatMessage{
-//	        {Role: mistral.RoleUser, Content: "Hello!"},
+//	        {Role: mistral.RoleUser, Content: mistral.TextContent("Hello!")},
 //	    },
 //	})
 func (c *Client) CreateChatCompletion(ctx context.Context, req *ChatCompletionRequest) (*ChatCompletionResponse, error) {
@@ -252,12 +305,12 @@ func (c *Client) CreateChatCompletion(ctx context.Context, req *ChatCompletionRe
 //	respChan, errChan := client.CreateChatCompletionStream(ctx, &mistral.ChatCompletionRequest{
 //	    Model: "mistral-large-latest",
 //	    Messages: []mistral.ChatMessage{
-//	        {Role: mistral.RoleUser, Content: "Tell me a story"},
+//	        {Role: mistral.RoleUser, Content: mistral.TextContent("Tell me a story")},
 //	    },
 //	})
 //	for chunk := range respChan {
 //	    // Process each chunk as it arrives
-//	    fmt.Print(chunk.Choices[0].Delta.Content)
+//	    fmt.Print(chunk.Choices[0].Delta.Content.Text())
 //	}
 //	if err := <-errChan; err != nil {
 //	    // Handle error
@@ -272,7 +325,7 @@ func (c *Client) CreateChatCompletionStream(ctx context.Context, req *ChatComple
 		defer close(respChan)
 		defer close(errChan)
 
-		if err := c.streamChatCompletion(ctx, req, respChan); err != nil {
+		if err := c.streamChatCompletion(ctx, "/v1/chat/completions", req, respChan); err != nil {
 			errChan <- err
 		}
 	}()
@@ -282,27 +335,82 @@ func (c *Client) CreateChatCompletionStream(ctx context.Context, req *ChatComple
 
 // streamChatCompletion is an internal helper that handles the streaming chat completion logic.
 // It creates the HTTP request, processes server-sent events, and sends chunks to the response channel.
+// It is shared by every endpoint that streams chat completion chunks.
 //
 // Parameters:
 //   - ctx: Context for request cancellation
-//   - req: The chat completion request (with Stream set to true)
+//   - path: API endpoint path (e.g., "/v1/chat/completions")
+//   - req: The completion request (with Stream set to true)
 //   - respChan: Channel to send response chunks to
 //
 // Returns:
 //   - An error if the stream fails, or nil if it completes successfully
-func (c *Client) streamChatCompletion(ctx context.Context, req *ChatCompletionRequest, respChan chan<- ChatCompletionStreamResponse) error {
-	jsonData, err := json.Marshal(req)
+func (c *Client) streamChatCompletion(ctx context.Context, path string, req interface{}, respChan chan<- ChatCompletionStreamResponse) error {
+	return c.streamEvents(ctx, path, req, func(event serverSentEvent) error {
+		var chunk ChatCompletionStreamResponse
+		if err := json.Unmarshal(event.Data, &chunk); err != nil {
+			return fmt.Errorf("failed to unmarshal stream chunk: %w", err)
+		}
+
+		select {
+		case respChan <- chunk:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// serverSentEvent is a single event read from a text/event-stream response.
+type serverSentEvent struct {
+	// Event is the value of the "event:" field, or empty if the server did not name the event.
+	Event string
+
+	// Data is the value of the "data:" field. Multiple data lines are joined with newlines.
+	Data []byte
+}
+
+// streamEvents is an internal helper shared by all streaming endpoints. It POSTs the JSON-encoded
+// body to path, parses the server-sent events of the response, and calls handle for each event
+// until the stream ends or a "[DONE]" sentinel is received.
+//
+// Parameters:
+//   - ctx: Context for request cancellation
+//   - path: API endpoint path (e.g., "/v1/chat/completions")
+//   - body: Request body to be JSON-encoded
+//   - handle: Callback invoked for each event; returning an error aborts the stream
+//
+// Returns:
+//   - An error if the request or the stream fails, or nil if it completes successfully
+func (c *Client) streamEvents(ctx context.Context, path string, body interface{}, handle func(serverSentEvent) error) error {
+	jsonData, err := json.Marshal(body)
 	if err != nil {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/chat/completions", strings.NewReader(string(jsonData)))
+	return c.streamRawEvents(ctx, path, "application/json", bytes.NewReader(jsonData), handle)
+}
+
+// streamRawEvents is like streamEvents, but POSTs an already encoded body with the given
+// content type. It is used by streaming endpoints that don't take JSON, such as multipart uploads.
+//
+// Parameters:
+//   - ctx: Context for request cancellation
+//   - path: API endpoint path (e.g., "/v1/audio/transcriptions")
+//   - contentType: The Content-Type of body
+//   - body: The encoded request body
+//   - handle: Callback invoked for each event; returning an error aborts the stream
+//
+// Returns:
+//   - An error if the request or the stream fails, or nil if it completes successfully
+func (c *Client) streamRawEvents(ctx context.Context, path, contentType string, body io.Reader, handle func(serverSentEvent) error) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, body)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
-	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Content-Type", contentType)
 	httpReq.Header.Set("Accept", "text/event-stream")
 
 	httpResp, err := c.httpClient.Do(httpReq)
@@ -315,27 +423,71 @@ func (c *Client) streamChatCompletion(ctx context.Context, req *ChatCompletionRe
 		return c.handleErrorResponse(httpResp)
 	}
 
-	scanner := bufio.NewScanner(httpResp.Body)
+	return readServerSentEvents(httpResp.Body, handle)
+}
+
+// readServerSentEvents parses a text/event-stream body and calls handle for each complete event.
+// Events are dispatched on blank lines (and at the end of the stream); comment lines and unknown
+// fields are ignored. Reading stops without error when a "[DONE]" data payload is received.
+//
+// Parameters:
+//   - r: The event stream to read
+//   - handle: Callback invoked for each event; returning an error aborts reading
+//
+// Returns:
+//   - An error if reading fails or handle returns an error, or nil at the end of the stream
+func readServerSentEvents(r io.Reader, handle func(serverSentEvent) error) error {
+	var (
+		event   serverSentEvent
+		data    []string
+		hasData bool
+	)
+
+	dispatch := func() (bool, error) {
+		defer func() {
+			event = serverSentEvent{}
+			data = data[:0]
+			hasData = false
+		}()
+
+		if !hasData {
+			return false, nil
+		}
+		payload := strings.Join(data, "\n")
+		if payload == "[DONE]" {
+			return true, nil
+		}
+		event.Data = []byte(payload)
+		return false, handle(event)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
 	for scanner.Scan() {
 		line := scanner.Text()
-		if !strings.HasPrefix(line, "data: ") {
-			continue
-		}
 
-		data := strings.TrimPrefix(line, "data: ")
-		if data == "[DONE]" {
-			break
+		if line == "" {
+			done, err := dispatch()
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+			continue
 		}
 
-		var chunk ChatCompletionStreamResponse
-		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
-			return fmt.Errorf("failed to unmarshal stream chunk: %w", err)
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
 		}
 
-		select {
-		case respChan <- chunk:
-		case <-ctx.Done():
-			return ctx.Err()
+		switch field {
+		case "event":
+			event.Event = value
+		case "data":
+			data = append(data, value)
+			hasData = true
 		}
 	}
 
@@ -343,7 +495,8 @@ func (c *Client) streamChatCompletion(ctx context.Context, req *ChatCompletionRe
 		return fmt.Errorf("error reading stream: %w", err)
 	}
 
-	return nil
+	_, err := dispatch()
+	return err
 }
 
 // CreateEmbedding creates embeddings for the given input texts.
@@ -420,29 +573,10 @@ func (c *Client) UploadFile(ctx context.Context, req *UploadFileRequest) (*File,
 		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/files", &buf)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
-	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, c.handleErrorResponse(resp)
-	}
-
 	var file File
-	if err := json.NewDecoder(resp.Body).Decode(&file); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.doMultipartRequest(ctx, "/v1/files", writer.FormDataContentType(), &buf, &file); err != nil {
+		return nil, err
 	}
-
 	return &file, nil
 }
 
@@ -680,3 +814,2152 @@ func (c *Client) DeleteModel(ctx context.Context, modelID string) (*DeleteModelR
 	}
 	return &resp, nil
 }
+
+// StartConversation creates a new conversation and runs a completion on its inputs.
+// The conversation is bound either to a model (req.Model) or to an agent (req.AgentID).
+// Any tool executions and agent handoffs are performed on the server and returned as
+// entries in the response. Use the returned ConversationID to continue the conversation.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The conversation request containing the inputs and either a model or an agent ID
+//
+// Returns:
+//   - A ConversationResponse with the new conversation's ID and output entries, or an error
+//
+// Example:
+//
+//	resp, err := client.StartConversation(ctx, &mistral.ConversationRequest{
+//	    Model: "mistral-medium-latest",
+//	    Inputs: mistral.ConversationEntries{
+//	        &mistral.MessageInputEntry{Role: mistral.RoleUser, Content: mistral.TextContent("Hello!")},
+//	    },
+//	})
+func (c *Client) StartConversation(ctx context.Context, req *ConversationRequest) (*ConversationResponse, error) {
+	var resp ConversationResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/conversations", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListConversations retrieves the conversations of your account, sorted by creation time.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - params: Optional pagination parameters. Pass nil to use defaults
+//
+// Returns:
+//   - A slice of Conversation objects, or an error if the request fails
+//
+// Example:
+//
+//	conversations, err := client.ListConversations(ctx, &mistral.ListConversationsParams{
+//	    PageSize: 20,
+//	})
+func (c *Client) ListConversations(ctx context.Context, params *ListConversationsParams) ([]Conversation, error) {
+	path := "/v1/conversations"
+	if params != nil {
+		query := url.Values{}
+		if params.Page > 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PageSize > 0 {
+			query.Set("page_size", strconv.Itoa(params.PageSize))
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var resp []Conversation
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetConversation retrieves the metadata of a conversation by its ID.
+// Use GetConversationHistory or GetConversationMessages to retrieve its content.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation
+//
+// Returns:
+//   - A Conversation object, or an error if the conversation doesn't exist or the request fails
+//
+// Example:
+//
+//	conversation, err := client.GetConversation(ctx, "conv_abc123")
+func (c *Client) GetConversation(ctx context.Context, conversationID string) (*Conversation, error) {
+	var resp Conversation
+	path := fmt.Sprintf("/v1/conversations/%s", conversationID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AppendToConversation appends new entries to an existing conversation and runs a
+// completion on the whole history. This is how you send a follow-up message or return
+// function results after a FunctionCallEntry.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation
+//   - req: The append request containing the new input entries
+//
+// Returns:
+//   - A ConversationResponse with the newly created output entries, or an error
+//
+// Example:
+//
+//	resp, err := client.AppendToConversation(ctx, "conv_abc123", &mistral.ConversationAppendRequest{
+//	    Inputs: mistral.ConversationEntries{
+//	        &mistral.FunctionResultEntry{ToolCallID: call.ToolCallID, Result: `{"temp": 21}`},
+//	    },
+//	})
+func (c *Client) AppendToConversation(ctx context.Context, conversationID string, req *ConversationAppendRequest) (*ConversationResponse, error) {
+	var resp ConversationResponse
+	path := fmt.Sprintf("/v1/conversations/%s", conversationID)
+	if err := c.doRequest(ctx, http.MethodPost, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetConversationHistory retrieves all entries of a conversation in the order they were
+// appended, including messages, function calls, tool executions and agent handoffs.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation
+//
+// Returns:
+//   - A ConversationHistory containing the typed entries, or an error if the request fails
+//
+// Example:
+//
+//	history, err := client.GetConversationHistory(ctx, "conv_abc123")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, entry := range history.Entries {
+//	    switch e := entry.(type) {
+//	    case *mistral.MessageOutputEntry:
+//	        fmt.Println(e.Content.Text())
+//	    case *mistral.FunctionCallEntry:
+//	        fmt.Println("called", e.Name)
+//	    }
+//	}
+func (c *Client) GetConversationHistory(ctx context.Context, conversationID string) (*ConversationHistory, error) {
+	var resp ConversationHistory
+	path := fmt.Sprintf("/v1/conversations/%s/history", conversationID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetConversationMessages retrieves only the message entries of a conversation.
+// This is similar to GetConversationHistory but filters out tool executions,
+// function calls and handoffs.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation
+//
+// Returns:
+//   - A ConversationMessages containing the message entries, or an error if the request fails
+//
+// Example:
+//
+//	messages, err := client.GetConversationMessages(ctx, "conv_abc123")
+func (c *Client) GetConversationMessages(ctx context.Context, conversationID string) (*ConversationMessages, error) {
+	var resp ConversationMessages
+	path := fmt.Sprintf("/v1/conversations/%s/messages", conversationID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RestartConversation creates a new conversation from the history of an existing one up to
+// a given entry, appends the new inputs and runs a completion. The original conversation
+// is left unchanged.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation to restart
+//   - req: The restart request containing the entry to restart from and the new inputs
+//
+// Returns:
+//   - A ConversationResponse for the new conversation, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.RestartConversation(ctx, "conv_abc123", &mistral.ConversationRestartRequest{
+//	    FromEntryID: "msg_xyz789",
+//	    Inputs: mistral.ConversationEntries{
+//	        &mistral.MessageInputEntry{Role: mistral.RoleUser, Content: mistral.TextContent("Try again, shorter.")},
+//	    },
+//	})
+func (c *Client) RestartConversation(ctx context.Context, conversationID string, req *ConversationRestartRequest) (*ConversationResponse, error) {
+	var resp ConversationResponse
+	path := fmt.Sprintf("/v1/conversations/%s/restart", conversationID)
+	if err := c.doRequest(ctx, http.MethodPost, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StartConversationStream starts a new conversation and streams the response as typed events.
+// This method returns two channels: one for receiving events as they're generated (message
+// deltas, tool executions, function calls, agent handoffs, etc.), and one for errors.
+// This automatically sets req.Stream to true.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The conversation request. The Stream field will be set to true automatically
+//
+// Returns:
+//   - A channel that receives ConversationEvent values as they arrive
+//   - A channel that receives at most one error (or nil if the stream completes successfully)
+//
+// Both channels are closed when the stream ends or an error occurs. Events of a type unknown
+// to this library are skipped.
+//
+// Example:
+//
+//	eventChan, errChan := client.StartConversationStream(ctx, &mistral.ConversationRequest{
+//	    AgentID: "ag_abc123",
+//	    Inputs: mistral.ConversationEntries{
+//	        &mistral.MessageInputEntry{Role: mistral.RoleUser, Content: mistral.TextContent("Find the latest news")},
+//	    },
+//	})
+//	for event := range eventChan {
+//	    switch e := event.(type) {
+//	    case *mistral.MessageOutputEvent:
+//	        fmt.Print(e.Content.Text())
+//	    case *mistral.ToolExecutionStartedEvent:
+//	        fmt.Printf("\n[running %s]\n", e.Name)
+//	    }
+//	}
+//	if err := <-errChan; err != nil {
+//	    // Handle error
+//	}
+func (c *Client) StartConversationStream(ctx context.Context, req *ConversationRequest) (<-chan ConversationEvent, <-chan error) {
+	req.Stream = true
+	return c.streamConversation(ctx, "/v1/conversations", req)
+}
+
+// AppendToConversationStream appends new entries to an existing conversation and streams
+// the response as typed events. This automatically sets req.Stream to true.
+// See StartConversationStream for details about the returned channels.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation
+//   - req: The append request. The Stream field will be set to true automatically
+//
+// Returns:
+//   - A channel that receives ConversationEvent values as they arrive
+//   - A channel that receives at most one error (or nil if the stream completes successfully)
+//
+// Example:
+//
+//	eventChan, errChan := client.AppendToConversationStream(ctx, "conv_abc123", &mistral.ConversationAppendRequest{
+//	    Inputs: mistral.ConversationEntries{
+//	        &mistral.MessageInputEntry{Role: mistral.RoleUser, Content: mistral.TextContent("And tomorrow?")},
+//	    },
+//	})
+func (c *Client) AppendToConversationStream(ctx context.Context, conversationID string, req *ConversationAppendRequest) (<-chan ConversationEvent, <-chan error) {
+	req.Stream = true
+	return c.streamConversation(ctx, fmt.Sprintf("/v1/conversations/%s", conversationID), req)
+}
+
+// RestartConversationStream restarts a conversation from a given entry and streams the
+// response of the new conversation as typed events. This automatically sets req.Stream to true.
+// See StartConversationStream for details about the returned channels.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - conversationID: The unique identifier of the conversation to restart
+//   - req: The restart request. The Stream field will be set to true automatically
+//
+// Returns:
+//   - A channel that receives ConversationEvent values as they arrive
+//   - A channel that receives at most one error (or nil if the stream completes successfully)
+//
+// Example:
+//
+//	eventChan, errChan := client.RestartConversationStream(ctx, "conv_abc123", &mistral.ConversationRestartRequest{
+//	    FromEntryID: "msg_xyz789",
+//	    Inputs: mistral.ConversationEntries{
+//	        &mistral.MessageInputEntry{Role: mistral.RoleUser, Content: mistral.TextContent("Try again")},
+//	    },
+//	})
+func (c *Client) RestartConversationStream(ctx context.Context, conversationID string, req *ConversationRestartRequest) (<-chan ConversationEvent, <-chan error) {
+	req.Stream = true
+	return c.streamConversation(ctx, fmt.Sprintf("/v1/conversations/%s/restart", conversationID), req)
+}
+
+// streamConversation is an internal helper shared by the conversation streaming methods.
+// It starts the stream in a goroutine and decodes each server-sent event into its concrete
+// ConversationEvent type, using the "event:" name (or the payload's "type" field as a fallback).
+//
+// Parameters:
+//   - ctx: Context for request cancellation
+//   - path: API endpoint path of the conversation operation
+//   - body: The request body (with Stream set to true)
+//
+// Returns:
+//   - A channel of decoded events and a channel that receives at most one error
+func (c *Client) streamConversation(ctx context.Context, path string, body interface{}) (<-chan ConversationEvent, <-chan error) {
+	eventChan := make(chan ConversationEvent)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(eventChan)
+		defer close(errChan)
+
+		err := c.streamEvents(ctx, path, body, func(sse serverSentEvent) error {
+			event, err := decodeConversationEvent(ConversationEventType(sse.Event), sse.Data)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal stream event: %w", err)
+			}
+			if event == nil {
+				return nil
+			}
+
+			select {
+			case eventChan <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	return eventChan, errChan
+}
+
+// CreateAgent creates a new agent with a model, instructions, tools and completion arguments.
+// The agent can then be used in conversations (see ConversationRequest.AgentID) or as a
+// handoff target of other agents.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The agent creation request containing at least the model and the name
+//
+// Returns:
+//   - The created Agent (including its ID), or an error if the request fails
+//
+// Example:
+//
+//	agent, err := client.CreateAgent(ctx, &mistral.AgentCreationRequest{
+//	    Model:        "mistral-medium-latest",
+//	    Name:         "Support bot",
+//	    Instructions: "You answer customer questions politely.",
+//	    Tools: []mistral.AgentTool{
+//	        {Type: mistral.AgentToolTypeWebSearch},
+//	    },
+//	})
+func (c *Client) CreateAgent(ctx context.Context, req *AgentCreationRequest) (*Agent, error) {
+	var resp Agent
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/agents", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAgents retrieves the agents of your account, sorted by creation time.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - params: Optional pagination parameters. Pass nil to use defaults
+//
+// Returns:
+//   - A slice of Agent objects, or an error if the request fails
+//
+// Example:
+//
+//	agents, err := client.ListAgents(ctx, &mistral.ListAgentsParams{
+//	    Page:     1,
+//	    PageSize: 50,
+//	})
+func (c *Client) ListAgents(ctx context.Context, params *ListAgentsParams) ([]Agent, error) {
+	path := "/v1/agents"
+	if params != nil {
+		query := url.Values{}
+		if params.Page > 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PageSize > 0 {
+			query.Set("page_size", strconv.Itoa(params.PageSize))
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var resp []Agent
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAgent retrieves an agent by its ID.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - agentID: The unique identifier of the agent
+//
+// Returns:
+//   - The Agent, or an error if the agent doesn't exist or the request fails
+//
+// Example:
+//
+//	agent, err := client.GetAgent(ctx, "ag_abc123")
+func (c *Client) GetAgent(ctx context.Context, agentID string) (*Agent, error) {
+	var resp Agent
+	path := fmt.Sprintf("/v1/agents/%s", agentID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateAgent updates the attributes of an agent. Each update creates a new version of the
+// agent, which becomes the active version.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - agentID: The unique identifier of the agent
+//   - req: The fields to update. Unset fields are left unchanged
+//
+// Returns:
+//   - The updated Agent, or an error if the request fails
+//
+// Example:
+//
+//	agent, err := client.UpdateAgent(ctx, "ag_abc123", &mistral.AgentUpdateRequest{
+//	    Instructions: "Answer in French.",
+//	})
+func (c *Client) UpdateAgent(ctx context.Context, agentID string, req *AgentUpdateRequest) (*Agent, error) {
+	var resp Agent
+	path := fmt.Sprintf("/v1/agents/%s", agentID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateAgentVersion switches the active version of an agent, for example to roll back
+// an update.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - agentID: The unique identifier of the agent
+//   - version: The version to make active
+//
+// Returns:
+//   - The Agent at the selected version, or an error if the request fails
+//
+// Example:
+//
+//	agent, err := client.UpdateAgentVersion(ctx, "ag_abc123", 2)
+func (c *Client) UpdateAgentVersion(ctx context.Context, agentID string, version int) (*Agent, error) {
+	var resp Agent
+	path := fmt.Sprintf("/v1/agents/%s/version?version=%d", agentID, version)
+	if err := c.doRequest(ctx, http.MethodPatch, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateAgentCompletion creates a chat completion using an agent instead of a model.
+// The agent's model, instructions and tools are applied to the provided messages.
+// For streaming responses, use CreateAgentCompletionStream instead.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The agents completion request containing the agent ID and messages
+//
+// Returns:
+//   - A ChatCompletionResponse with the generated completion and metadata, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.CreateAgentCompletion(ctx, &mistral.AgentsCompletionRequest{
+//	    AgentID: "ag_abc123",
+//	    Messages: []mistral.ChatMessage{
+//	        {Role: mistral.RoleUser, Content: mistral.TextContent("Where is my order?")},
+//	    },
+//	})
+func (c *Client) CreateAgentCompletion(ctx context.Context, req *AgentsCompletionRequest) (*ChatCompletionResponse, error) {
+	var resp ChatCompletionResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/agents/completions", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateAgentCompletionStream creates a streaming chat completion using an agent.
+// It behaves like CreateChatCompletionStream and returns the same chunk type.
+// This automatically sets req.Stream to true.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The agents completion request. The Stream field will be set to true automatically
+//
+// Returns:
+//   - A channel that receives ChatCompletionStreamResponse chunks as they arrive
+//   - A channel that receives at most one error (or nil if the stream completes successfully)
+//
+// Both channels are closed when the stream ends or an error occurs.
+//
+// Example:
+//
+//	respChan, errChan := client.CreateAgentCompletionStream(ctx, &mistral.AgentsCompletionRequest{
+//	    AgentID: "ag_abc123",
+//	    Messages: []mistral.ChatMessage{
+//	        {Role: mistral.RoleUser, Content: mistral.TextContent("Where is my order?")},
+//	    },
+//	})
+//	for chunk := range respChan {
+//	    fmt.Print(chunk.Choices[0].Delta.Content.Text())
+//	}
+//	if err := <-errChan; err != nil {
+//	    // Handle error
+//	}
+func (c *Client) CreateAgentCompletionStream(ctx context.Context, req *AgentsCompletionRequest) (<-chan ChatCompletionStreamResponse, <-chan error) {
+	req.Stream = true
+
+	respChan := make(chan ChatCompletionStreamResponse)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(respChan)
+		defer close(errChan)
+
+		if err := c.streamChatCompletion(ctx, "/v1/agents/completions", req, respChan); err != nil {
+			errChan <- err
+		}
+	}()
+
+	return respChan, errChan
+}
+
+// CreateFIMCompletion creates a fill-in-the-middle code completion.
+// The model generates the code that belongs between req.Prompt and req.Suffix.
+// The response has the same shape as a chat completion: the generated code is the
+// content of the first choice's message. For streaming responses, use
+// CreateFIMCompletionStream instead.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The FIM request containing the model, prompt and optional suffix
+//
+// Returns:
+//   - A ChatCompletionResponse with the generated code, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.CreateFIMCompletion(ctx, &mistral.FIMCompletionRequest{
+//	    Model:  "codestral-latest",
+//	    Prompt: "def fibonacci(n):\n",
+//	    Suffix: "\nprint(fibonacci(10))",
+//	})
+func (c *Client) CreateFIMCompletion(ctx context.Context, req *FIMCompletionRequest) (*ChatCompletionResponse, error) {
+	var resp ChatCompletionResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/fim/completions", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateFIMCompletionStream creates a streaming fill-in-the-middle code completion.
+// It behaves like CreateChatCompletionStream and returns the same chunk type.
+// This automatically sets req.Stream to true.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The FIM request. The Stream field will be set to true automatically
+//
+// Returns:
+//   - A channel that receives ChatCompletionStreamResponse chunks as they arrive
+//   - A channel that receives at most one error (or nil if the stream completes successfully)
+//
+// Both channels are closed when the stream ends or an error occurs.
+//
+// Example:
+//
+//	respChan, errChan := client.CreateFIMCompletionStream(ctx, &mistral.FIMCompletionRequest{
+//	    Model:  "codestral-latest",
+//	    Prompt: "func add(a, b int) int {\n",
+//	    Suffix: "\n}",
+//	})
+//	for chunk := range respChan {
+//	    fmt.Print(chunk.Choices[0].Delta.Content.Text())
+//	}
+//	if err := <-errChan; err != nil {
+//	    // Handle error
+//	}
+func (c *Client) CreateFIMCompletionStream(ctx context.Context, req *FIMCompletionRequest) (<-chan ChatCompletionStreamResponse, <-chan error) {
+	req.Stream = true
+
+	respChan := make(chan ChatCompletionStreamResponse)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(respChan)
+		defer close(errChan)
+
+		if err := c.streamChatCompletion(ctx, "/v1/fim/completions", req, respChan); err != nil {
+			errChan <- err
+		}
+	}()
+
+	return respChan, errChan
+}
+
+// CreateFineTuningJob creates a fine-tuning job that trains a custom model on your uploaded
+// training files. Upload the files first with UploadFile and FilePurposeFineTune.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The job request containing the base model, training files and hyperparameters
+//
+// Returns:
+//   - The created job as a *CompletionJob or *ClassifierJob, or an error if the request fails
+//
+// Example:
+//
+//	job, err := client.CreateFineTuningJob(ctx, &mistral.FineTuningJobRequest{
+//	    Model:         "open-mistral-nemo",
+//	    TrainingFiles: []mistral.TrainingFile{{FileID: trainingFile.ID}},
+//	    Hyperparameters: mistral.TrainingParameters{
+//	        TrainingSteps: &steps,
+//	    },
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(job.Details().ID, job.Details().Status)
+func (c *Client) CreateFineTuningJob(ctx context.Context, req *FineTuningJobRequest) (FineTuningJob, error) {
+	return c.doFineTuningJobRequest(ctx, http.MethodPost, "/v1/fine_tuning/jobs", req)
+}
+
+// ListFineTuningJobs retrieves a paginated list of fine-tuning jobs.
+// You can filter by model, creation time, status, creator, Weights & Biases project or run,
+// and model suffix.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - params: Optional filtering and pagination parameters. Pass nil to use defaults
+//
+// Returns:
+//   - A FineTuningJobList containing the jobs and the total count, or an error if the request fails
+//
+// Example:
+//
+//	jobs, err := client.ListFineTuningJobs(ctx, &mistral.ListFineTuningJobsParams{
+//	    Status:      mistral.FineTuningJobStatusRunning,
+//	    CreatedByMe: true,
+//	})
+func (c *Client) ListFineTuningJobs(ctx context.Context, params *ListFineTuningJobsParams) (*FineTuningJobList, error) {
+	path := "/v1/fine_tuning/jobs"
+	if params != nil {
+		query := url.Values{}
+		if params.Page > 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PageSize > 0 {
+			query.Set("page_size", strconv.Itoa(params.PageSize))
+		}
+		if params.Model != "" {
+			query.Set("model", params.Model)
+		}
+		if !params.CreatedAfter.IsZero() {
+			query.Set("created_after", params.CreatedAfter.Format(time.RFC3339))
+		}
+		if !params.CreatedBefore.IsZero() {
+			query.Set("created_before", params.CreatedBefore.Format(time.RFC3339))
+		}
+		if params.CreatedByMe {
+			query.Set("created_by_me", "true")
+		}
+		if params.Status != "" {
+			query.Set("status", string(params.Status))
+		}
+		if params.WandbProject != "" {
+			query.Set("wandb_project", params.WandbProject)
+		}
+		if params.WandbName != "" {
+			query.Set("wandb_name", params.WandbName)
+		}
+		if params.Suffix != "" {
+			query.Set("suffix", params.Suffix)
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var resp FineTuningJobList
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetFineTuningJob retrieves a fine-tuning job by its ID, including its lifecycle events
+// and its checkpoints with training metrics.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - jobID: The unique identifier of the job
+//
+// Returns:
+//   - The job as a *CompletionJob or *ClassifierJob, or an error if the job doesn't exist
+//     or the request fails
+//
+// Example:
+//
+//	job, err := client.GetFineTuningJob(ctx, "job-abc123")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, checkpoint := range job.Details().Checkpoints {
+//	    if loss := checkpoint.Metrics.TrainLoss; loss != nil {
+//	        fmt.Println(checkpoint.StepNumber, *loss)
+//	    }
+//	}
+func (c *Client) GetFineTuningJob(ctx context.Context, jobID string) (FineTuningJob, error) {
+	path := fmt.Sprintf("/v1/fine_tuning/jobs/%s", jobID)
+	return c.doFineTuningJobRequest(ctx, http.MethodGet, path, nil)
+}
+
+// CancelFineTuningJob requests the cancellation of a fine-tuning job.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - jobID: The unique identifier of the job to cancel
+//
+// Returns:
+//   - The updated job as a *CompletionJob or *ClassifierJob, or an error if the request fails
+//
+// Example:
+//
+//	job, err := client.CancelFineTuningJob(ctx, "job-abc123")
+func (c *Client) CancelFineTuningJob(ctx context.Context, jobID string) (FineTuningJob, error) {
+	path := fmt.Sprintf("/v1/fine_tuning/jobs/%s/cancel", jobID)
+	return c.doFineTuningJobRequest(ctx, http.MethodPost, path, nil)
+}
+
+// StartFineTuningJob starts training for a validated job that was created with AutoStart
+// set to false.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - jobID: The unique identifier of the job to start
+//
+// Returns:
+//   - The updated job as a *CompletionJob or *ClassifierJob, or an error if the request fails
+//
+// Example:
+//
+//	job, err := client.StartFineTuningJob(ctx, "job-abc123")
+func (c *Client) StartFineTuningJob(ctx context.Context, jobID string) (FineTuningJob, error) {
+	path := fmt.Sprintf("/v1/fine_tuning/jobs/%s/start", jobID)
+	return c.doFineTuningJobRequest(ctx, http.MethodPost, path, nil)
+}
+
+// doFineTuningJobRequest is an internal helper that performs a request returning a single
+// fine-tuning job and decodes it into its concrete type.
+//
+// Parameters:
+//   - ctx: Context for request cancellation
+//   - method: HTTP method
+//   - path: API endpoint path
+//   - body: Request body to be JSON-encoded, or nil for no body
+//
+// Returns:
+//   - The job as a *CompletionJob or *ClassifierJob, or an error
+func (c *Client) doFineTuningJobRequest(ctx context.Context, method, path string, body interface{}) (FineTuningJob, error) {
+	var raw json.RawMessage
+	if err := c.doRequest(ctx, method, path, body, &raw); err != nil {
+		return nil, err
+	}
+
+	job, err := unmarshalFineTuningJob(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return job, nil
+}
+
+// UpdateFineTunedModel updates the name and/or description of a fine-tuned model.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - modelID: The unique identifier of the fine-tuned model
+//   - req: The fields to update. Unset fields are left unchanged
+//
+// Returns:
+//   - The updated Model, or an error if the model doesn't exist or the request fails
+//
+// Example:
+//
+//	model, err := client.UpdateFineTunedModel(ctx, "ft:open-mistral-7b:587a6b29:20240514:7e773925", &mistral.UpdateFineTunedModelRequest{
+//	    Name:        "support-bot-v2",
+//	    Description: "Trained on Q3 support tickets",
+//	})
+func (c *Client) UpdateFineTunedModel(ctx context.Context, modelID string, req *UpdateFineTunedModelRequest) (*Model, error) {
+	var resp Model
+	path := fmt.Sprintf("/v1/fine_tuning/models/%s", modelID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ArchiveModel archives a fine-tuned model. Archived models are hidden from model listings
+// but, unlike deleted models, can be restored with UnarchiveModel.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - modelID: The unique identifier of the fine-tuned model to archive
+//
+// Returns:
+//   - An ArchiveModelResponse confirming the new state, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.ArchiveModel(ctx, "ft:open-mistral-7b:587a6b29:20240514:7e773925")
+func (c *Client) ArchiveModel(ctx context.Context, modelID string) (*ArchiveModelResponse, error) {
+	var resp ArchiveModelResponse
+	path := fmt.Sprintf("/v1/fine_tuning/models/%s/archive", modelID)
+	if err := c.doRequest(ctx, http.MethodPost, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UnarchiveModel restores a previously archived fine-tuned model.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - modelID: The unique identifier of the fine-tuned model to unarchive
+//
+// Returns:
+//   - An ArchiveModelResponse confirming the new state, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.UnarchiveModel(ctx, "ft:open-mistral-7b:587a6b29:20240514:7e773925")
+func (c *Client) UnarchiveModel(ctx context.Context, modelID string) (*ArchiveModelResponse, error) {
+	var resp ArchiveModelResponse
+	path := fmt.Sprintf("/v1/fine_tuning/models/%s/archive", modelID)
+	if err := c.doRequest(ctx, http.MethodDelete, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateBatchJob creates a batch job that runs every request of the input files against
+// a single endpoint. Batch jobs are processed asynchronously; poll GetBatchJob until the
+// job reaches a terminal state, then download OutputFile and ErrorFile with DownloadFile.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The batch job request containing the input files, endpoint and model or agent
+//
+// Returns:
+//   - The created BatchJob, or an error if the request fails
+//
+// Example:
+//
+//	file, err := client.UploadFile(ctx, &mistral.UploadFileRequest{
+//	    File:     f,
+//	    Filename: "requests.jsonl",
+//	    Purpose:  mistral.FilePurposeBatch,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	job, err := client.CreateBatchJob(ctx, &mistral.BatchJobRequest{
+//	    InputFiles: []string{file.ID},
+//	    Endpoint:   mistral.BatchEndpointChatCompletions,
+//	    Model:      "mistral-small-latest",
+//	    Metadata:   map[string]string{"pipeline": "nightly-scoring"},
+//	})
+func (c *Client) CreateBatchJob(ctx context.Context, req *BatchJobRequest) (*BatchJob, error) {
+	var resp BatchJob
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/batch/jobs", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListBatchJobs retrieves a paginated list of batch jobs.
+// You can filter by model, agent, creation time, creator and status.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - params: Optional filtering and pagination parameters. Pass nil to use defaults
+//
+// Returns:
+//   - A BatchJobList containing the jobs and the total count, or an error if the request fails
+//
+// Example:
+//
+//	jobs, err := client.ListBatchJobs(ctx, &mistral.ListBatchJobsParams{
+//	    Status:      []mistral.BatchJobStatus{mistral.BatchJobStatusQueued, mistral.BatchJobStatusRunning},
+//	    CreatedByMe: true,
+//	})
+func (c *Client) ListBatchJobs(ctx context.Context, params *ListBatchJobsParams) (*BatchJobList, error) {
+	path := "/v1/batch/jobs"
+	if params != nil {
+		query := url.Values{}
+		if params.Page > 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PageSize > 0 {
+			query.Set("page_size", strconv.Itoa(params.PageSize))
+		}
+		if params.Model != "" {
+			query.Set("model", params.Model)
+		}
+		if params.AgentID != "" {
+			query.Set("agent_id", params.AgentID)
+		}
+		if !params.CreatedAfter.IsZero() {
+			query.Set("created_after", params.CreatedAfter.Format(time.RFC3339))
+		}
+		if params.CreatedByMe {
+			query.Set("created_by_me", "true")
+		}
+		for _, status := range params.Status {
+			query.Add("status", string(status))
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var resp BatchJobList
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetBatchJob retrieves a batch job by its ID, including its progress counters and,
+// once processing has finished, its output and error file IDs.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - jobID: The unique identifier of the batch job
+//
+// Returns:
+//   - The BatchJob, or an error if the job doesn't exist or the request fails
+//
+// Example:
+//
+//	job, err := client.GetBatchJob(ctx, "b-abc123")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("%d/%d requests done\n", job.CompletedRequests, job.TotalRequests)
+func (c *Client) GetBatchJob(ctx context.Context, jobID string) (*BatchJob, error) {
+	var resp BatchJob
+	path := fmt.Sprintf("/v1/batch/jobs/%s", jobID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelBatchJob requests the cancellation of a batch job.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - jobID: The unique identifier of the batch job to cancel
+//
+// Returns:
+//   - The updated BatchJob, or an error if the request fails
+//
+// Example:
+//
+//	job, err := client.CancelBatchJob(ctx, "b-abc123")
+func (c *Client) CancelBatchJob(ctx context.Context, jobID string) (*BatchJob, error) {
+	var resp BatchJob
+	path := fmt.Sprintf("/v1/batch/jobs/%s/cancel", jobID)
+	if err := c.doRequest(ctx, http.MethodPost, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateModeration classifies raw text against the moderation categories.
+// Use it to screen user input before passing it to a model.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The moderation request containing the model and the text to moderate
+//
+// Returns:
+//   - A ModerationResponse containing one result per input, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.CreateModeration(ctx, &mistral.ModerationRequest{
+//	    Model: "mistral-moderation-latest",
+//	    Input: []string{"first text", "second text"},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, result := range resp.Results {
+//	    fmt.Println(result.Flagged(), result.FlaggedCategories())
+//	}
+func (c *Client) CreateModeration(ctx context.Context, req *ModerationRequest) (*ModerationResponse, error) {
+	var resp ModerationResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/moderations", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateChatModeration classifies the last message of a conversation against the
+// moderation categories, taking the previous messages into account.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The moderation request containing the model and the conversation to moderate
+//
+// Returns:
+//   - A ModerationResponse containing one result per conversation, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.CreateChatModeration(ctx, &mistral.ChatModerationRequest{
+//	    Model: "mistral-moderation-latest",
+//	    Input: []mistral.ChatMessage{
+//	        {Role: mistral.RoleUser, Content: mistral.TextContent(userPrompt)},
+//	    },
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	if resp.Results[0].Flagged() {
+//	    return errors.New("prompt rejected by moderation")
+//	}
+func (c *Client) CreateChatModeration(ctx context.Context, req *ChatModerationRequest) (*ModerationResponse, error) {
+	var resp ModerationResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/chat/moderations", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateClassification classifies raw text with a classifier model, such as one produced
+// by a classifier fine-tuning job.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The classification request containing the model and the text to classify
+//
+// Returns:
+//   - A ClassificationResponse containing per-target scores for each input, or an error
+//     if the request fails
+//
+// Example:
+//
+//	resp, err := client.CreateClassification(ctx, &mistral.ClassificationRequest{
+//	    Model: "ft:ministral-3b-latest:587a6b29:20250101:intent",
+//	    Input: "I'd like to cancel my subscription",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	label, score := resp.Results[0]["intent"].Top()
+func (c *Client) CreateClassification(ctx context.Context, req *ClassificationRequest) (*ClassificationResponse, error) {
+	var resp ClassificationResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/classifications", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateChatClassification classifies conversations with a classifier model, such as one
+// produced by a classifier fine-tuning job.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The classification request containing the model and the conversations to classify
+//
+// Returns:
+//   - A ClassificationResponse containing per-target scores for each conversation, or an
+//     error if the request fails
+//
+// Example:
+//
+//	resp, err := client.CreateChatClassification(ctx, &mistral.ChatClassificationRequest{
+//	    Model: "ft:ministral-3b-latest:587a6b29:20250101:intent",
+//	    Input: mistral.ClassificationChat{
+//	        Messages: []mistral.ChatMessage{
+//	            {Role: mistral.RoleUser, Content: mistral.TextContent("My order hasn't arrived")},
+//	        },
+//	    },
+//	})
+func (c *Client) CreateChatClassification(ctx context.Context, req *ChatClassificationRequest) (*ClassificationResponse, error) {
+	var resp ClassificationResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/chat/classifications", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ProcessOCR extracts the text of a document as Markdown, page by page, along with the
+// images it contains. The document can be a PDF or other document referenced by URL, an
+// image, or a file uploaded with UploadFile.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The OCR request containing the model, the document and extraction options
+//
+// Returns:
+//   - An OCRResponse containing the content of each page, or an error if the request fails
+//
+// Example:
+//
+//	resp, err := client.ProcessOCR(ctx, &mistral.OCRRequest{
+//	    Model: "mistral-ocr-latest",
+//	    Document: mistral.DocumentURLChunk{
+//	        DocumentURL: "https://example.com/invoice.pdf",
+//	    },
+//	    IncludeImageBase64: true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, page := range resp.Pages {
+//	    fmt.Println(page.Markdown)
+//	}
+func (c *Client) ProcessOCR(ctx context.Context, req *OCRRequest) (*OCRResponse, error) {
+	var resp OCRResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/ocr", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateTranscription transcribes an audio file. The audio can be uploaded from a reader,
+// downloaded from a URL, or taken from a file previously uploaded with UploadFile. The
+// request is sent as multipart form data, like UploadFile.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The transcription request containing the model, the audio and transcription options
+//
+// Returns:
+//   - A TranscriptionResponse containing the text and, if requested, timestamped segments,
+//     or an error if the request fails
+//
+// Example:
+//
+//	f, err := os.Open("call.mp3")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//
+//	resp, err := client.CreateTranscription(ctx, &mistral.TranscriptionRequest{
+//	    Model:                  "voxtral-mini-latest",
+//	    File:                   f,
+//	    Filename:               "call.mp3",
+//	    TimestampGranularities: []mistral.TimestampGranularity{mistral.TimestampGranularitySegment},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, segment := range resp.Segments {
+//	    fmt.Printf("[%.1f-%.1f] %s\n", segment.Start, segment.End, segment.Text)
+//	}
+func (c *Client) CreateTranscription(ctx context.Context, req *TranscriptionRequest) (*TranscriptionResponse, error) {
+	body, contentType, err := newTranscriptionForm(req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TranscriptionResponse
+	if err := c.doMultipartRequest(ctx, "/v1/audio/transcriptions", contentType, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateTranscriptionStream transcribes an audio file and streams the transcription as typed
+// events. This method returns two channels: one for receiving events as they're generated
+// (detected language, text deltas, segments and the final transcription), and one for errors.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The transcription request containing the model, the audio and transcription options
+//
+// Returns:
+//   - A channel that receives TranscriptionEvent values as they arrive
+//   - A channel that receives at most one error (or nil if the stream completes successfully)
+//
+// Both channels are closed when the stream ends or an error occurs. Events of a type unknown
+// to this library are skipped.
+//
+// Example:
+//
+//	eventChan, errChan := client.CreateTranscriptionStream(ctx, &mistral.TranscriptionRequest{
+//	    Model:   "voxtral-mini-latest",
+//	    FileURL: "https://example.com/call.mp3",
+//	})
+//	for event := range eventChan {
+//	    switch e := event.(type) {
+//	    case *mistral.TranscriptionTextDeltaEvent:
+//	        fmt.Print(e.Text)
+//	    case *mistral.TranscriptionDoneEvent:
+//	        fmt.Printf("\n%d tokens\n", e.Usage.TotalTokens)
+//	    }
+//	}
+//	if err := <-errChan; err != nil {
+//	    // Handle error
+//	}
+func (c *Client) CreateTranscriptionStream(ctx context.Context, req *TranscriptionRequest) (<-chan TranscriptionEvent, <-chan error) {
+	eventChan := make(chan TranscriptionEvent)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(eventChan)
+		defer close(errChan)
+
+		body, contentType, err := newTranscriptionForm(req, true)
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		err = c.streamRawEvents(ctx, "/v1/audio/transcriptions", contentType, body, func(sse serverSentEvent) error {
+			event, err := decodeTranscriptionEvent(TranscriptionEventType(sse.Event), sse.Data)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal stream event: %w", err)
+			}
+			if event == nil {
+				return nil
+			}
+
+			select {
+			case eventChan <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	return eventChan, errChan
+}
+
+// newTranscriptionForm encodes a transcription request as multipart form data.
+//
+// Parameters:
+//   - req: The transcription request to encode
+//   - stream: Whether to request a streamed response
+//
+// Returns:
+//   - The encoded body, its Content-Type, or an error if the request doesn't set exactly one
+//     of File, FileURL or FileID, or if encoding fails
+func newTranscriptionForm(req *TranscriptionRequest, stream bool) (*bytes.Buffer, string, error) {
+	sources := 0
+	for _, set := range []bool{req.File != nil, req.FileURL != "", req.FileID != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, "", errors.New("exactly one of File, FileURL or FileID must be set")
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	fields := [][2]string{
+		{"model", req.Model},
+		{"file_url", req.FileURL},
+		{"file_id", req.FileID},
+		{"language", req.Language},
+	}
+	if req.Temperature != nil {
+		fields = append(fields, [2]string{"temperature", strconv.FormatFloat(*req.Temperature, 'f', -1, 64)})
+	}
+	if stream {
+		fields = append(fields, [2]string{"stream", "true"})
+	}
+	for _, granularity := range req.TimestampGranularities {
+		fields = append(fields, [2]string{"timestamp_granularities", string(granularity)})
+	}
+
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return nil, "", fmt.Errorf("failed to write %s field: %w", field[0], err)
+		}
+	}
+
+	if req.File != nil {
+		part, err := writer.CreateFormFile("file", req.Filename)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create form file: %w", err)
+		}
+		if _, err := io.Copy(part, req.File); err != nil {
+			return nil, "", fmt.Errorf("failed to copy file data: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	return &buf, writer.FormDataContentType(), nil
+}
+
+// CreateLibrary creates a new document library. The caller becomes its owner and is
+// initially the only one with access to it.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - req: The library creation request containing at least the name
+//
+// Returns:
+//   - The created Library, or an error if the request fails
+//
+// Example:
+//
+//	library, err := client.CreateLibrary(ctx, &mistral.LibraryRequest{
+//	    Name:        "Support handbook",
+//	    Description: "Internal procedures for the support team",
+//	})
+func (c *Client) CreateLibrary(ctx context.Context, req *LibraryRequest) (*Library, error) {
+	var resp Library
+	if err := c.doRequest(ctx, http.MethodPost, "/v1/libraries", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListLibraries retrieves all the document libraries the caller has access to.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//
+// Returns:
+//   - A slice of Library objects, or an error if the request fails
+//
+// Example:
+//
+//	libraries, err := client.ListLibraries(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, library := range libraries {
+//	    fmt.Println(library.Name, library.NbDocuments)
+//	}
+func (c *Client) ListLibraries(ctx context.Context) ([]Library, error) {
+	var resp LibraryList
+	if err := c.doRequest(ctx, http.MethodGet, "/v1/libraries", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// GetLibrary retrieves a document library by its ID.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//
+// Returns:
+//   - The Library, or an error if the library doesn't exist or the request fails
+//
+// Example:
+//
+//	library, err := client.GetLibrary(ctx, "0197f1c2-...")
+func (c *Client) GetLibrary(ctx context.Context, libraryID string) (*Library, error) {
+	var resp Library
+	path := fmt.Sprintf("/v1/libraries/%s", libraryID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateLibrary updates the name or description of a document library.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library to update
+//   - req: The fields to update
+//
+// Returns:
+//   - The updated Library, or an error if the request fails
+//
+// Example:
+//
+//	library, err := client.UpdateLibrary(ctx, "0197f1c2-...", &mistral.LibraryUpdateRequest{
+//	    Description: "Procedures and escalation paths for the support team",
+//	})
+func (c *Client) UpdateLibrary(ctx context.Context, libraryID string, req *LibraryUpdateRequest) (*Library, error) {
+	var resp Library
+	path := fmt.Sprintf("/v1/libraries/%s", libraryID)
+	if err := c.doRequest(ctx, http.MethodPut, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteLibrary deletes a document library together with all its documents.
+// This operation cannot be undone.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library to delete
+//
+// Returns:
+//   - The deleted Library, or an error if the request fails
+//
+// Example:
+//
+//	_, err := client.DeleteLibrary(ctx, "0197f1c2-...")
+func (c *Client) DeleteLibrary(ctx context.Context, libraryID string) (*Library, error) {
+	var resp Library
+	path := fmt.Sprintf("/v1/libraries/%s", libraryID)
+	if err := c.doRequest(ctx, http.MethodDelete, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UploadLibraryDocument uploads a document to a library. The document is processed
+// asynchronously and only becomes searchable once processing has completed; use
+// GetLibraryDocumentStatus to follow its progress. If a document with the same content
+// already exists in the library, the existing document is returned.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - req: The upload request containing the document content and filename
+//
+// Returns:
+//   - The uploaded LibraryDocument, or an error if the upload fails
+//
+// Example:
+//
+//	f, err := os.Open("handbook.pdf")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//
+//	doc, err := client.UploadLibraryDocument(ctx, libraryID, &mistral.UploadLibraryDocumentRequest{
+//	    File:     f,
+//	    Filename: "handbook.pdf",
+//	})
+func (c *Client) UploadLibraryDocument(ctx context.Context, libraryID string, req *UploadLibraryDocumentRequest) (*LibraryDocument, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	part, err := writer.CreateFormFile("file", req.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create form file: %w", err)
+	}
+
+	if _, err := io.Copy(part, req.File); err != nil {
+		return nil, fmt.Errorf("failed to copy file data: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	var resp LibraryDocument
+	path := fmt.Sprintf("/v1/libraries/%s/documents", libraryID)
+	if err := c.doMultipartRequest(ctx, path, writer.FormDataContentType(), &buf, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListLibraryDocuments retrieves a paginated list of the documents of a library.
+// You can search documents by name and control sorting and pagination.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - params: Optional search, sorting and pagination parameters. Pass nil to use defaults
+//
+// Returns:
+//   - A LibraryDocumentList containing the documents and pagination information,
+//     or an error if the request fails
+//
+// Example:
+//
+//	docs, err := client.ListLibraryDocuments(ctx, libraryID, &mistral.ListLibraryDocumentsParams{
+//	    Search:    "onboarding",
+//	    SortBy:    "name",
+//	    SortOrder: "asc",
+//	})
+func (c *Client) ListLibraryDocuments(ctx context.Context, libraryID string, params *ListLibraryDocumentsParams) (*LibraryDocumentList, error) {
+	path := fmt.Sprintf("/v1/libraries/%s/documents", libraryID)
+	if params != nil {
+		query := url.Values{}
+		if params.Search != "" {
+			query.Set("search", params.Search)
+		}
+		if params.Page > 0 {
+			query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PageSize > 0 {
+			query.Set("page_size", strconv.Itoa(params.PageSize))
+		}
+		if params.SortBy != "" {
+			query.Set("sort_by", params.SortBy)
+		}
+		if params.SortOrder != "" {
+			query.Set("sort_order", params.SortOrder)
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	var resp LibraryDocumentList
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLibraryDocument retrieves a document of a library by its ID.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//
+// Returns:
+//   - The LibraryDocument, or an error if the document doesn't exist or the request fails
+//
+// Example:
+//
+//	doc, err := client.GetLibraryDocument(ctx, libraryID, documentID)
+func (c *Client) GetLibraryDocument(ctx context.Context, libraryID, documentID string) (*LibraryDocument, error) {
+	var resp LibraryDocument
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s", libraryID, documentID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateLibraryDocument updates the name of a library document.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document to update
+//   - req: The fields to update
+//
+// Returns:
+//   - The updated LibraryDocument, or an error if the request fails
+//
+// Example:
+//
+//	doc, err := client.UpdateLibraryDocument(ctx, libraryID, documentID, &mistral.LibraryDocumentUpdateRequest{
+//	    Name: "Support handbook 2025.pdf",
+//	})
+func (c *Client) UpdateLibraryDocument(ctx context.Context, libraryID, documentID string, req *LibraryDocumentUpdateRequest) (*LibraryDocument, error) {
+	var resp LibraryDocument
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s", libraryID, documentID)
+	if err := c.doRequest(ctx, http.MethodPut, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteLibraryDocument deletes a document from a library. This operation cannot be undone.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document to delete
+//
+// Returns:
+//   - An error if the request fails, or nil on success
+//
+// Example:
+//
+//	err := client.DeleteLibraryDocument(ctx, libraryID, documentID)
+func (c *Client) DeleteLibraryDocument(ctx context.Context, libraryID, documentID string) error {
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s", libraryID, documentID)
+	return c.doRequest(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// GetLibraryDocumentTextContent retrieves the text extracted from a library document.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//
+// Returns:
+//   - The DocumentTextContent, or an error if the request fails
+//
+// Example:
+//
+//	content, err := client.GetLibraryDocumentTextContent(ctx, libraryID, documentID)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(content.Text)
+func (c *Client) GetLibraryDocumentTextContent(ctx context.Context, libraryID, documentID string) (*DocumentTextContent, error) {
+	var resp DocumentTextContent
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s/text_content", libraryID, documentID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLibraryDocumentStatus retrieves the processing state of a library document.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//
+// Returns:
+//   - The document's ProcessingStatus, or an error if the request fails
+//
+// Example:
+//
+//	status, err := client.GetLibraryDocumentStatus(ctx, libraryID, documentID)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(status.ProcessingStatus)
+func (c *Client) GetLibraryDocumentStatus(ctx context.Context, libraryID, documentID string) (*ProcessingStatus, error) {
+	var resp ProcessingStatus
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s/status", libraryID, documentID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLibraryDocumentSignedURL retrieves a temporary URL to download the original content
+// of a library document.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//
+// Returns:
+//   - The signed URL, or an error if the request fails
+//
+// Example:
+//
+//	signedURL, err := client.GetLibraryDocumentSignedURL(ctx, libraryID, documentID)
+func (c *Client) GetLibraryDocumentSignedURL(ctx context.Context, libraryID, documentID string) (string, error) {
+	var resp string
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s/signed-url", libraryID, documentID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp, nil
+}
+
+// GetLibraryDocumentExtractedTextSignedURL retrieves a temporary URL to download the text
+// extracted from a library document.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//
+// Returns:
+//   - The signed URL, or an error if the request fails
+//
+// Example:
+//
+//	signedURL, err := client.GetLibraryDocumentExtractedTextSignedURL(ctx, libraryID, documentID)
+func (c *Client) GetLibraryDocumentExtractedTextSignedURL(ctx context.Context, libraryID, documentID string) (string, error) {
+	var resp string
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s/extracted-text-signed-url", libraryID, documentID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp, nil
+}
+
+// ReprocessLibraryDocument queues a library document to be processed again, for example
+// after a processing failure.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//
+// Returns:
+//   - An error if the request fails, or nil on success
+//
+// Example:
+//
+//	err := client.ReprocessLibraryDocument(ctx, libraryID, documentID)
+func (c *Client) ReprocessLibraryDocument(ctx context.Context, libraryID, documentID string) error {
+	path := fmt.Sprintf("/v1/libraries/%s/documents/%s/reprocess", libraryID, documentID)
+	return c.doRequest(ctx, http.MethodPost, path, nil, nil)
+}
+
+// ListLibrarySharing retrieves the users, workspaces and organizations a library is shared
+// with, along with their access levels.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//
+// Returns:
+//   - A slice of LibrarySharing objects, or an error if the request fails
+//
+// Example:
+//
+//	shares, err := client.ListLibrarySharing(ctx, libraryID)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, share := range shares {
+//	    fmt.Println(share.ShareWithType, share.ShareWithUUID, share.Role)
+//	}
+func (c *Client) ListLibrarySharing(ctx context.Context, libraryID string) ([]LibrarySharing, error) {
+	var resp LibrarySharingList
+	path := fmt.Sprintf("/v1/libraries/%s/share", libraryID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ShareLibrary grants a user, workspace or organization access to a library, or changes
+// the access level of an entity the library is already shared with. Only the owner of
+// the library can share it.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - req: The sharing request containing the entity and the access level
+//
+// Returns:
+//   - The resulting LibrarySharing, or an error if the request fails
+//
+// Example:
+//
+//	share, err := client.ShareLibrary(ctx, libraryID, &mistral.SharingRequest{
+//	    OrgID:         orgID,
+//	    Level:         mistral.ShareLevelViewer,
+//	    ShareWithUUID: workspaceID,
+//	    ShareWithType: mistral.EntityTypeWorkspace,
+//	})
+func (c *Client) ShareLibrary(ctx context.Context, libraryID string, req *SharingRequest) (*LibrarySharing, error) {
+	var resp LibrarySharing
+	path := fmt.Sprintf("/v1/libraries/%s/share", libraryID)
+	if err := c.doRequest(ctx, http.MethodPut, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UnshareLibrary revokes an entity's access to a library. The owner's own access cannot
+// be revoked, and only the owner can revoke the access of other entities.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - libraryID: The unique identifier of the library
+//   - req: The request identifying the entity to revoke access from
+//
+// Returns:
+//   - The revoked LibrarySharing, or an error if the request fails
+//
+// Example:
+//
+//	_, err := client.UnshareLibrary(ctx, libraryID, &mistral.SharingDeleteRequest{
+//	    OrgID:         orgID,
+//	    ShareWithUUID: userID,
+//	    ShareWithType: mistral.EntityTypeUser,
+//	})
+func (c *Client) UnshareLibrary(ctx context.Context, libraryID string, req *SharingDeleteRequest) (*LibrarySharing, error) {
+	var resp LibrarySharing
+	path := fmt.Sprintf("/v1/libraries/%s/share", libraryID)
+	if err := c.doRequest(ctx, http.MethodDelete, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WaitForDocumentProcessed polls the processing status of a library document until it
+// has been processed or has failed, backing off between polls as configured by opts.
+// The Completed and Failed statuses are matched case-insensitively; any other status, such
+// as Running, is treated as still processing.
+//
+// Parameters:
+//   - ctx: Context for cancellation. Use a context with a deadline to bound the wait
+//   - libraryID: The unique identifier of the library
+//   - documentID: The unique identifier of the document
+//   - opts: Optional polling configuration. Pass nil to use defaults
+//
+// Returns:
+//   - The final ProcessingStatus on success
+//   - The final ProcessingStatus and a *DocumentProcessingError if processing failed
+//   - The last retrieved ProcessingStatus, if any, and ctx.Err() if ctx is done first,
+//     or an error if a status request fails
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+//	defer cancel()
+//
+//	status, err := client.WaitForDocumentProcessed(ctx, libraryID, doc.ID, nil)
+//	var procErr *mistral.DocumentProcessingError
+//	if errors.As(err, &procErr) {
+//	    err = client.ReprocessLibraryDocument(ctx, libraryID, doc.ID)
+//	}
+func (c *Client) WaitForDocumentProcessed(ctx context.Context, libraryID, documentID string, opts *PollOptions) (*ProcessingStatus, error) {
+	var status *ProcessingStatus
+	err := poll(ctx, opts, func() (bool, error) {
+		s, err := c.GetLibraryDocumentStatus(ctx, libraryID, documentID)
+		if err != nil {
+			return false, err
+		}
+		status = s
+
+		switch state := string(s.ProcessingStatus); {
+		case strings.EqualFold(state, string(DocumentProcessingStatusCompleted)):
+			return true, nil
+		case strings.EqualFold(state, string(DocumentProcessingStatusFailed)):
+			return false, &DocumentProcessingError{Status: s}
+		default:
+			return false, nil
+		}
+	})
+	return status, err
+}
+
+// WaitForDocumentsProcessed waits concurrently for several library documents to be
+// processed, as WaitForDocumentProcessed does for a single document. At most
+// opts.MaxConcurrency documents (4 by default) are polled at once, to stay within rate
+// limits; the others wait until a document being polled is done.
+//
+// Parameters:
+//   - ctx: Context for cancellation. Use a context with a deadline to bound the wait
+//   - libraryID: The unique identifier of the library
+//   - documentIDs: The unique identifiers of the documents
+//   - opts: Optional polling configuration. Pass nil to use defaults
+//
+// Returns:
+//   - One DocumentWaitResult per document, in the order of documentIDs
+//   - The first non-nil DocumentWaitResult.Err in that order, or nil if all documents
+//     were processed
+//
+// Example:
+//
+//	results, err := client.WaitForDocumentsProcessed(ctx, libraryID, docIDs, nil)
+//	if err != nil {
+//	    for _, result := range results {
+//	        if result.Err != nil {
+//	            log.Printf("%s: %v", result.DocumentID, result.Err)
+//	        }
+//	    }
+//	}
+func (c *Client) WaitForDocumentsProcessed(ctx context.Context, libraryID string, documentIDs []string, opts *PollOptions) ([]DocumentWaitResult, error) {
+	results := make([]DocumentWaitResult, len(documentIDs))
+
+	concurrency := defaultMaxConcurrency
+	if opts != nil && opts.MaxConcurrency > 0 {
+		concurrency = opts.MaxConcurrency
+	}
+	if concurrency > len(documentIDs) {
+		concurrency = len(documentIDs)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				documentID := documentIDs[i]
+				status, err := c.WaitForDocumentProcessed(ctx, libraryID, documentID, opts)
+				results[i] = DocumentWaitResult{DocumentID: documentID, Status: status, Err: err}
+			}
+		}()
+	}
+	for i := range documentIDs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, result := range results {
+		if result.Err != nil {
+			return results, result.Err
+		}
+	}
+	return results, nil
+}
+
+// WaitForBatchJob polls a batch job until it reaches a terminal state (SUCCESS, FAILED,
+// TIMEOUT_EXCEEDED or CANCELLED), backing off between polls as configured by opts.
+// Reaching a terminal state is not an error: check the returned job's Status.
+//
+// Parameters:
+//   - ctx: Context for cancellation. Use a context with a deadline to bound the wait
+//   - jobID: The unique identifier of the batch job
+//   - opts: Optional polling configuration. Pass nil to use defaults
+//   - onChange: Optional callback invoked with the job on the first poll and whenever its
+//     status or request counters change. Pass nil to ignore intermediate states
+//
+// Returns:
+//   - The job in its terminal state
+//   - The last retrieved job, if any, and ctx.Err() if ctx is done first, or an error if
+//     a request fails
+//
+// Example:
+//
+//	job, err := client.WaitForBatchJob(ctx, job.ID, &mistral.PollOptions{
+//	    Interval:    10 * time.Second,
+//	    MaxInterval: time.Minute,
+//	    Jitter:      0.2,
+//	}, func(job *mistral.BatchJob) {
+//	    log.Printf("%s: %d/%d", job.Status, job.CompletedRequests, job.TotalRequests)
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	if job.Status != mistral.BatchJobStatusSuccess {
+//	    return fmt.Errorf("batch job ended with status %s", job.Status)
+//	}
+func (c *Client) WaitForBatchJob(ctx context.Context, jobID string, opts *PollOptions, onChange func(*BatchJob)) (*BatchJob, error) {
+	var job *BatchJob
+	err := poll(ctx, opts, func() (bool, error) {
+		latest, err := c.GetBatchJob(ctx, jobID)
+		if err != nil {
+			return false, err
+		}
+
+		changed := job == nil ||
+			latest.Status != job.Status ||
+			latest.CompletedRequests != job.CompletedRequests ||
+			latest.SucceededRequests != job.SucceededRequests ||
+			latest.FailedRequests != job.FailedRequests
+		job = latest
+		if changed && onChange != nil {
+			onChange(job)
+		}
+
+		return job.Status.IsTerminal(), nil
+	})
+	return job, err
+}
+
+// WaitForFineTuningJob polls a fine-tuning job until it reaches a terminal state (SUCCESS,
+// FAILED, FAILED_VALIDATION or CANCELLED), backing off between polls as configured by opts.
+// Reaching a terminal state is not an error: check the returned job's status.
+//
+// Parameters:
+//   - ctx: Context for cancellation. Use a context with a deadline to bound the wait
+//   - jobID: The unique identifier of the fine-tuning job
+//   - opts: Optional polling configuration. Pass nil to use defaults
+//   - onChange: Optional callback invoked on the first poll and whenever the job's status
+//     changes or new events or checkpoints appear. Pass nil to ignore intermediate states
+//
+// Returns:
+//   - The job in its terminal state, as a *CompletionJob or *ClassifierJob
+//   - The last retrieved job, if any, and ctx.Err() if ctx is done first, or an error if
+//     a request fails
+//
+// Example:
+//
+//	job, err := client.WaitForFineTuningJob(ctx, "job-abc123", nil, func(u mistral.FineTuningJobUpdate) {
+//	    if u.StatusChanged {
+//	        log.Println("status:", u.Job.Details().Status)
+//	    }
+//	    for _, checkpoint := range u.NewCheckpoints {
+//	        if loss := checkpoint.Metrics.TrainLoss; loss != nil {
+//	            log.Printf("step %d: train loss %v", checkpoint.StepNumber, *loss)
+//	        }
+//	    }
+//	})
+func (c *Client) WaitForFineTuningJob(ctx context.Context, jobID string, opts *PollOptions, onChange func(FineTuningJobUpdate)) (FineTuningJob, error) {
+	var job FineTuningJob
+	var tracker fineTuningJobTracker
+	err := poll(ctx, opts, func() (bool, error) {
+		latest, err := c.GetFineTuningJob(ctx, jobID)
+		if err != nil {
+			return false, err
+		}
+		job = latest
+
+		if update, ok := tracker.update(job); ok && onChange != nil {
+			onChange(update)
+		}
+
+		return job.Details().Status.IsTerminal(), nil
+	})
+	return job, err
+}
+
+// GetFileSignedURL returns a temporary URL to download a file without an API key.
+// This lets you hand a file, such as the output of a batch job, to another system
+// without proxying its content through your application.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - fileID: The unique identifier of the file
+//   - expiryHours: The number of hours before the URL expires. If 0, the URL is valid
+//     for 24 hours
+//
+// Returns:
+//   - A FileSignedURL containing the download URL, or an error if the file doesn't
+//     exist or the request fails
+//
+// Example:
+//
+//	job, err := client.GetBatchJob(ctx, "batch-abc123")
+//	if err != nil {
+//	    return err
+//	}
+//	signed, err := client.GetFileSignedURL(ctx, job.OutputFile, 1)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(signed.URL)
+func (c *Client) GetFileSignedURL(ctx context.Context, fileID string, expiryHours int) (*FileSignedURL, error) {
+	var resp FileSignedURL
+	path := fmt.Sprintf("/v1/files/%s/url", fileID)
+	if expiryHours > 0 {
+		path += fmt.Sprintf("?expiry=%d", expiryHours)
+	}
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Attach reads an image or PDF document from r and returns a content chunk referencing it.
+// Attachments within the inline size limit are embedded in the message as a base64 data URL,
+// like NewImageChunk and NewDocumentChunk do. Larger PDF documents are uploaded with
+// UploadFile and referenced by a FileChunk instead, so they don't have to be sent with every
+// request. Larger images are rejected with an *AttachmentTooLargeError.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - r: The content of the image (PNG, JPEG, WebP or GIF) or PDF document
+//   - opts: Optional size limit, image detail, name and upload purpose. Pass nil to use defaults
+//
+// Returns:
+//   - An ImageURLChunk or DocumentURLChunk for inlined attachments, or a FileChunk for
+//     uploaded documents
+//   - An *AttachmentTooLargeError for images over the size limit, or an error if the format
+//     is not supported or the upload fails
+//
+// Example:
+//
+//	chunk, err := client.AttachFile(ctx, "annual-report.pdf", &mistral.AttachmentOptions{MaxSize: 5 << 20})
+//	if err != nil {
+//	    return err
+//	}
+//	resp, err := client.CreateChatCompletion(ctx, &mistral.ChatCompletionRequest{
+//	    Model: "mistral-medium-latest",
+//	    Messages: []mistral.ChatMessage{{
+//	        Role:    mistral.RoleUser,
+//	        Content: mistral.ChunkContent(mistral.TextChunk{Text: "Summarize this report"}, chunk),
+//	    }},
+//	})
+func (c *Client) Attach(ctx context.Context, r io.Reader, opts *AttachmentOptions) (ContentChunk, error) {
+	if opts == nil {
+		opts = &AttachmentOptions{}
+	}
+
+	mimeType, r, err := sniffAttachment(r)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk, rest, err := inlineAttachment(mimeType, r, opts)
+	var tooLarge *AttachmentTooLargeError
+	if !errors.As(err, &tooLarge) || mimeType != documentMIMEType {
+		return chunk, err
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = "attachment" + attachmentExtensions[mimeType]
+	}
+	purpose := opts.Purpose
+	if purpose == "" {
+		purpose = FilePurposeOCR
+	}
+
+	file, err := c.UploadFile(ctx, &UploadFileRequest{File: rest, Filename: name, Purpose: purpose})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	return FileChunk{FileID: file.ID}, nil
+}
+
+// AttachFile reads the image or PDF document at path and returns a content chunk referencing
+// it, uploading documents that are too large to inline. The base name of path is used as the
+// name of the attachment unless opts.Name is set. See Attach.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - path: The path of the image (PNG, JPEG, WebP or GIF) or PDF document
+//   - opts: Optional size limit, image detail, name and upload purpose. Pass nil to use defaults
+//
+// Returns:
+//   - An ImageURLChunk or DocumentURLChunk for inlined attachments, or a FileChunk for
+//     uploaded documents
+//   - An *AttachmentTooLargeError for images over the size limit, or an error if the file
+//     can't be read, the format is not supported or the upload fails
+//
+// Example:
+//
+//	chunk, err := client.AttachFile(ctx, "screenshot.png", &mistral.AttachmentOptions{Detail: "high"})
+func (c *Client) AttachFile(ctx context.Context, path string, opts *AttachmentOptions) (ContentChunk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return c.Attach(ctx, f, withAttachmentName(opts, path))
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -51,7 +53,7 @@ func TestCreateChatCompletion(t *testing.T) {
 				Index: 0,
 				Message: ChatMessage{
 					Role:    RoleAssistant,
-					Content: "The capital of France is Paris.",
+					Content: TextContent("The capital of France is Paris."),
 				},
 				FinishReason: "stop",
 			},
@@ -88,7 +90,7 @@ func TestCreateChatCompletion(t *testing.T) {
 		Messages: []ChatMessage{
 			{
 				Role:    RoleUser,
-				Content: "What is the capital of France?",
+				Content: TextContent("What is the capital of France?"),
 			},
 		},
 	})
@@ -97,7 +99,7 @@ func TestCreateChatCompletion(t *testing.T) {
 	assert.Equal(t, expectedResponse.ID, resp.ID)
 	assert.Equal(t, expectedResponse.Model, resp.Model)
 	assert.Len(t, resp.Choices, 1)
-	assert.Equal(t, "The capital of France is Paris.", resp.Choices[0].Message.Content)
+	assert.Equal(t, "The capital of France is Paris.", resp.Choices[0].Message.Content.Text())
 }
 
 func TestCreateChatCompletionStream(t *testing.T) {
@@ -128,7 +130,7 @@ func TestCreateChatCompletionStream(t *testing.T) {
 						Index: 0,
 						Delta: &ChatMessage{
 							Role:    RoleAssistant,
-							Content: "Hello",
+							Content: TextContent("Hello"),
 						},
 					},
 				},
@@ -142,7 +144,7 @@ func TestCreateChatCompletionStream(t *testing.T) {
 					{
 						Index: 0,
 						Delta: &ChatMessage{
-							Content: " world",
+							Content: TextContent(" world"),
 						},
 					},
 				},
@@ -169,7 +171,7 @@ func TestCreateChatCompletionStream(t *testing.T) {
 		Messages: []ChatMessage{
 			{
 				Role:    RoleUser,
-				Content: "Say hello",
+				Content: TextContent("Say hello"),
 			},
 		},
 	})
@@ -181,8 +183,8 @@ func TestCreateChatCompletionStream(t *testing.T) {
 			if !ok {
 				// Channel closed, we're done
 				assert.Len(t, chunks, 2)
-				assert.Equal(t, "Hello", chunks[0].Choices[0].Delta.Content)
-				assert.Equal(t, " world", chunks[1].Choices[0].Delta.Content)
+				assert.Equal(t, "Hello", chunks[0].Choices[0].Delta.Content.Text())
+				assert.Equal(t, " world", chunks[1].Choices[0].Delta.Content.Text())
 				return
 			}
 			chunks = append(chunks, chunk)
@@ -250,7 +252,7 @@ func TestUploadFile(t *testing.T) {
 		ID:        "file-123",
 		Object:    "file",
 		Bytes:     1024,
-		CreatedAt: time.Now(),
+		CreatedAt: 1700000000,
 		Filename:  "test.jsonl",
 		Purpose:   "fine-tune",
 	}
@@ -299,7 +301,7 @@ func TestListFiles(t *testing.T) {
 				ID:        "file-1",
 				Object:    "file",
 				Bytes:     1024,
-				CreatedAt: time.Now(),
+				CreatedAt: 1700000000,
 				Filename:  "test1.jsonl",
 				Purpose:   "fine-tune",
 			},
@@ -307,7 +309,7 @@ func TestListFiles(t *testing.T) {
 				ID:        "file-2",
 				Object:    "file",
 				Bytes:     2048,
-				CreatedAt: time.Now(),
+				CreatedAt: 1700000000,
 				Filename:  "test2.jsonl",
 				Purpose:   "fine-tune",
 			},
@@ -361,7 +363,7 @@ func TestGetFile(t *testing.T) {
 		ID:        "file-123",
 		Object:    "file",
 		Bytes:     1024,
-		CreatedAt: time.Now(),
+		CreatedAt: 1700000000,
 		Filename:  "test.jsonl",
 		Purpose:   "fine-tune",
 	}
@@ -432,6 +434,51 @@ func TestDownloadFile(t *testing.T) {
 	assert.Equal(t, expectedContent, string(content))
 }
 
+func TestGetFileSignedURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/files/file-123/url", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("expiry"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"url": "https://files.example.com/file-123?sig=abc"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetFileSignedURL(context.Background(), "file-123", 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://files.example.com/file-123?sig=abc", resp.URL)
+}
+
+func TestGetFileMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "file-123", "object": "file", "bytes": 2048, "created_at": 1700000000,
+			"filename": "batch-out.jsonl", "purpose": "batch", "sample_type": "batch_result",
+			"num_lines": 12, "mimetype": "application/jsonl", "source": "mistral", "signature": "abc123"
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	file, err := client.GetFile(context.Background(), "file-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000000), file.CreatedAt)
+	assert.Equal(t, FilePurposeBatch, file.Purpose)
+	assert.Equal(t, FileSampleTypeBatchResult, file.SampleType)
+	require.NotNil(t, file.NumLines)
+	assert.Equal(t, 12, *file.NumLines)
+	assert.Equal(t, "application/jsonl", file.MimeType)
+	assert.Equal(t, FileSourceMistral, file.Source)
+	assert.Equal(t, "abc123", file.Signature)
+}
+
 func TestListModels(t *testing.T) {
 	expectedResponse := ModelList{
 		Object: "list",
@@ -576,7 +623,7 @@ func TestHandleErrorResponse(t *testing.T) {
 				Messages: []ChatMessage{
 					{
 						Role:    RoleUser,
-						Content: "test",
+						Content: TextContent("test"),
 					},
 				},
 			})
@@ -608,7 +655,7 @@ func TestContextCancellation(t *testing.T) {
 		Messages: []ChatMessage{
 			{
 				Role:    RoleUser,
-				Content: "test",
+				Content: TextContent("test"),
 			},
 		},
 	})
@@ -632,7 +679,7 @@ func TestStreamContextCancellation(t *testing.T) {
 				{
 					Index: 0,
 					Delta: &ChatMessage{
-						Content: "test",
+						Content: TextContent("test"),
 					},
 				},
 			},
@@ -657,7 +704,7 @@ func TestStreamContextCancellation(t *testing.T) {
 		Messages: []ChatMessage{
 			{
 				Role:    RoleUser,
-				Content: "test",
+				Content: TextContent("test"),
 			},
 		},
 	})
@@ -665,7 +712,7 @@ func TestStreamContextCancellation(t *testing.T) {
 	// Read first chunk
 	select {
 	case chunk := <-respChan:
-		assert.Equal(t, "test", chunk.Choices[0].Delta.Content)
+		assert.Equal(t, "test", chunk.Choices[0].Delta.Content.Text())
 	case err := <-errChan:
 		t.Fatalf("unexpected error: %v", err)
 	case <-time.After(1 * time.Second):
@@ -773,3 +820,2170 @@ func TestUploadFileWithEmptyPurpose(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestStartConversation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/conversations", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+		var req map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "mistral-medium-latest", req["model"])
+		inputs := req["inputs"].([]interface{})
+		require.Len(t, inputs, 1)
+		assert.Equal(t, "message.input", inputs[0].(map[string]interface{})["type"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"object": "conversation.response",
+			"conversation_id": "conv-123",
+			"outputs": [
+				{"object": "entry", "type": "message.output", "id": "msg-1", "role": "assistant", "content": "Hi there!"}
+			],
+			"usage": {"prompt_tokens": 5, "completion_tokens": 3, "total_tokens": 8}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.StartConversation(context.Background(), &ConversationRequest{
+		Model: "mistral-medium-latest",
+		Inputs: ConversationEntries{
+			&MessageInputEntry{Role: RoleUser, Content: TextContent("Hello!")},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "conv-123", resp.ConversationID)
+	assert.Equal(t, 8, resp.Usage.TotalTokens)
+	require.Len(t, resp.Outputs, 1)
+	msg, ok := resp.Outputs[0].(*MessageOutputEntry)
+	require.True(t, ok, "output should be a *MessageOutputEntry")
+	assert.Equal(t, "Hi there!", msg.Content.Text())
+}
+
+func TestListConversations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/conversations", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "50", r.URL.Query().Get("page_size"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"object": "conversation", "id": "conv-1", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z", "model": "mistral-medium-latest"},
+			{"object": "conversation", "id": "conv-2", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z", "agent_id": "ag-1"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListConversations(context.Background(), &ListConversationsParams{
+		Page:     1,
+		PageSize: 50,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, "mistral-medium-latest", resp[0].Model)
+	assert.Equal(t, "ag-1", resp[1].AgentID)
+}
+
+func TestGetConversation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/conversations/conv-123", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object": "conversation", "id": "conv-123", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z", "model": "mistral-medium-latest", "name": "support"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetConversation(context.Background(), "conv-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "conv-123", resp.ID)
+	assert.Equal(t, "support", resp.Name)
+}
+
+func TestAppendToConversation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/conversations/conv-123", r.URL.Path)
+
+		var req map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		input := req["inputs"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "function.result", input["type"])
+		assert.Equal(t, "call-1", input["tool_call_id"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"conversation_id": "conv-123", "outputs": [], "usage": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.AppendToConversation(context.Background(), "conv-123", &ConversationAppendRequest{
+		Inputs: ConversationEntries{
+			&FunctionResultEntry{ToolCallID: "call-1", Result: `{"temperature": 21}`},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "conv-123", resp.ConversationID)
+}
+
+func TestGetConversationHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/conversations/conv-123/history", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"object": "conversation.history",
+			"conversation_id": "conv-123",
+			"entries": [
+				{"type": "message.input", "role": "user", "content": "Weather in Paris?"},
+				{"type": "function.call", "tool_call_id": "call-1", "name": "get_weather", "arguments": {"city": "Paris"}},
+				{"type": "function.result", "tool_call_id": "call-1", "result": "21C"},
+				{"type": "tool.execution", "name": "web_search", "arguments": "{}"},
+				{"type": "agent.handoff", "previous_agent_id": "ag-1", "previous_agent_name": "a", "next_agent_id": "ag-2", "next_agent_name": "b"},
+				{"type": "message.output", "role": "assistant", "content": "It is 21C."}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetConversationHistory(context.Background(), "conv-123")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 6)
+	assert.IsType(t, &MessageInputEntry{}, resp.Entries[0])
+	assert.IsType(t, &FunctionCallEntry{}, resp.Entries[1])
+	assert.IsType(t, &FunctionResultEntry{}, resp.Entries[2])
+	assert.IsType(t, &ToolExecutionEntry{}, resp.Entries[3])
+	assert.IsType(t, &AgentHandoffEntry{}, resp.Entries[4])
+	assert.IsType(t, &MessageOutputEntry{}, resp.Entries[5])
+	assert.JSONEq(t, `{"city": "Paris"}`, string(resp.Entries[1].(*FunctionCallEntry).Arguments))
+}
+
+func TestGetConversationMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/conversations/conv-123/messages", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"object": "conversation.messages",
+			"conversation_id": "conv-123",
+			"messages": [
+				{"type": "message.input", "role": "user", "content": "Hello"},
+				{"type": "message.output", "role": "assistant", "content": "Hi"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetConversationMessages(context.Background(), "conv-123")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Messages, 2)
+	assert.Equal(t, EntryTypeMessageInput, resp.Messages[0].EntryType())
+	assert.Equal(t, EntryTypeMessageOutput, resp.Messages[1].EntryType())
+}
+
+func TestRestartConversation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/conversations/conv-123/restart", r.URL.Path)
+
+		var req ConversationRestartRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "msg-1", req.FromEntryID)
+		require.Len(t, req.Inputs, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"conversation_id": "conv-456", "outputs": [], "usage": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.RestartConversation(context.Background(), "conv-123", &ConversationRestartRequest{
+		FromEntryID: "msg-1",
+		Inputs: ConversationEntries{
+			&MessageInputEntry{Role: RoleUser, Content: TextContent("Try again")},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "conv-456", resp.ConversationID)
+}
+
+func TestStartConversationStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/conversations", r.URL.Path)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		var req ConversationRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.True(t, req.Stream)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+
+		events := []string{
+			"event: conversation.response.started\ndata: {\"type\": \"conversation.response.started\", \"conversation_id\": \"conv-123\"}\n\n",
+			"event: tool.execution.started\ndata: {\"type\": \"tool.execution.started\", \"id\": \"tool-1\", \"name\": \"web_search\", \"arguments\": \"\"}\n\n",
+			"event: tool.execution.done\ndata: {\"type\": \"tool.execution.done\", \"id\": \"tool-1\", \"name\": \"web_search\"}\n\n",
+			"event: message.output.delta\ndata: {\"type\": \"message.output.delta\", \"id\": \"msg-1\", \"content\": \"Hello\"}\n\n",
+			"event: something.unknown\ndata: {\"type\": \"something.unknown\"}\n\n",
+			"event: message.output.delta\ndata: {\"type\": \"message.output.delta\", \"id\": \"msg-1\", \"content\": \" world\"}\n\n",
+			"event: conversation.response.done\ndata: {\"type\": \"conversation.response.done\", \"usage\": {\"total_tokens\": 12}}\n\n",
+		}
+		for _, event := range events {
+			w.Write([]byte(event))
+			flusher.Flush()
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	eventChan, errChan := client.StartConversationStream(context.Background(), &ConversationRequest{
+		AgentID: "ag-1",
+		Inputs: ConversationEntries{
+			&MessageInputEntry{Role: RoleUser, Content: TextContent("Say hello")},
+		},
+	})
+
+	var events []ConversationEvent
+	for event := range eventChan {
+		events = append(events, event)
+	}
+	require.NoError(t, <-errChan)
+
+	require.Len(t, events, 6)
+	assert.Equal(t, "conv-123", events[0].(*ResponseStartedEvent).ConversationID)
+	assert.Equal(t, BuiltInConnectorWebSearch, events[1].(*ToolExecutionStartedEvent).Name)
+	assert.IsType(t, &ToolExecutionDoneEvent{}, events[2])
+	assert.Equal(t, "Hello", events[3].(*MessageOutputEvent).Content.Text())
+	assert.Equal(t, " world", events[4].(*MessageOutputEvent).Content.Text())
+	assert.Equal(t, 12, events[5].(*ResponseDoneEvent).Usage.TotalTokens)
+}
+
+func TestAppendToConversationStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/conversations/conv-123", r.URL.Path)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("event: function.call.delta\ndata: {\"type\": \"function.call.delta\", \"id\": \"fc-1\", \"name\": \"get_weather\", \"tool_call_id\": \"call-1\", \"arguments\": \"{}\"}\n\n"))
+		w.Write([]byte("event: conversation.response.error\ndata: {\"type\": \"conversation.response.error\", \"message\": \"boom\", \"code\": 500}\n\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	eventChan, errChan := client.AppendToConversationStream(context.Background(), "conv-123", &ConversationAppendRequest{
+		Inputs: ConversationEntries{
+			&MessageInputEntry{Role: RoleUser, Content: TextContent("Weather?")},
+		},
+	})
+
+	var events []ConversationEvent
+	for event := range eventChan {
+		events = append(events, event)
+	}
+	require.NoError(t, <-errChan)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "call-1", events[0].(*FunctionCallEvent).ToolCallID)
+	assert.Equal(t, "boom", events[1].(*ResponseErrorEvent).Message)
+}
+
+func TestReadServerSentEvents(t *testing.T) {
+	stream := ": comment\n" +
+		"event: first\n" +
+		"data: line one\n" +
+		"data: line two\n" +
+		"\n" +
+		"data:no-space\n" +
+		"\n" +
+		"data: [DONE]\n\n" +
+		"data: after done\n\n"
+
+	var events []serverSentEvent
+	err := readServerSentEvents(strings.NewReader(stream), func(event serverSentEvent) error {
+		events = append(events, event)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "first", events[0].Event)
+	assert.Equal(t, "line one\nline two", string(events[0].Data))
+	assert.Equal(t, "", events[1].Event)
+	assert.Equal(t, "no-space", string(events[1].Data))
+}
+
+func TestCreateAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/agents", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"model": "mistral-medium-latest",
+			"name": "Support bot",
+			"tools": [
+				{"type": "function", "function": {"name": "lookup_order", "parameters": {"type": "object"}}},
+				{"type": "web_search"},
+				{"type": "document_library", "library_ids": ["lib-1"]}
+			]
+		}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"object": "agent", "id": "ag-123", "version": 1, "name": "Support bot", "model": "mistral-medium-latest",
+			"created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z",
+			"tools": [{"type": "web_search"}, {"type": "document_library", "library_ids": ["lib-1"]}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateAgent(context.Background(), &AgentCreationRequest{
+		Model: "mistral-medium-latest",
+		Name:  "Support bot",
+		Tools: []AgentTool{
+			{
+				Type: AgentToolTypeFunction,
+				Function: &ToolFunctionDetails{
+					Name:       "lookup_order",
+					Parameters: map[string]interface{}{"type": "object"},
+				},
+			},
+			{Type: AgentToolTypeWebSearch},
+			{Type: AgentToolTypeDocumentLibrary, LibraryIDs: []string{"lib-1"}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ag-123", resp.ID)
+	assert.Equal(t, 1, resp.Version)
+	require.Len(t, resp.Tools, 2)
+	assert.Equal(t, AgentToolTypeDocumentLibrary, resp.Tools[1].Type)
+	assert.Equal(t, []string{"lib-1"}, resp.Tools[1].LibraryIDs)
+}
+
+func TestListAgents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/agents", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "10", r.URL.Query().Get("page_size"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "ag-1", "name": "a", "model": "m", "version": 0, "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListAgents(context.Background(), &ListAgentsParams{Page: 2, PageSize: 10})
+
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "ag-1", resp[0].ID)
+}
+
+func TestGetAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/agents/ag-123", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ag-123", "name": "a", "model": "m", "version": 3, "handoffs": ["ag-2"], "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetAgent(context.Background(), "ag-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Version)
+	assert.Equal(t, []string{"ag-2"}, resp.Handoffs)
+}
+
+func TestUpdateAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/v1/agents/ag-123", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"instructions": "Answer in French."}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ag-123", "name": "a", "model": "m", "version": 2, "instructions": "Answer in French.", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UpdateAgent(context.Background(), "ag-123", &AgentUpdateRequest{
+		Instructions: "Answer in French.",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Version)
+	assert.Equal(t, "Answer in French.", resp.Instructions)
+}
+
+func TestUpdateAgentVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/v1/agents/ag-123/version", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("version"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ag-123", "name": "a", "model": "m", "version": 1, "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UpdateAgentVersion(context.Background(), "ag-123", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Version)
+}
+
+func TestCreateAgentCompletion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/agents/completions", r.URL.Path)
+
+		var req AgentsCompletionRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "ag-123", req.AgentID)
+		assert.False(t, req.Stream)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ChatCompletionResponse{
+			ID: "cmpl-1",
+			Choices: []ChatCompletionChoice{
+				{Message: ChatMessage{Role: RoleAssistant, Content: TextContent("Your order shipped.")}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateAgentCompletion(context.Background(), &AgentsCompletionRequest{
+		AgentID: "ag-123",
+		Messages: []ChatMessage{
+			{Role: RoleUser, Content: TextContent("Where is my order?")},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Your order shipped.", resp.Choices[0].Message.Content.Text())
+}
+
+func TestCreateAgentCompletionStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/agents/completions", r.URL.Path)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		var req AgentsCompletionRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.True(t, req.Stream)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, content := range []string{"Your order", " shipped."} {
+			data, _ := json.Marshal(ChatCompletionStreamResponse{
+				ID:      "cmpl-1",
+				Choices: []ChatCompletionChoice{{Delta: &ChatMessage{Content: TextContent(content)}}},
+			})
+			w.Write([]byte("data: " + string(data) + "\n\n"))
+		}
+		w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	respChan, errChan := client.CreateAgentCompletionStream(context.Background(), &AgentsCompletionRequest{
+		AgentID: "ag-123",
+		Messages: []ChatMessage{
+			{Role: RoleUser, Content: TextContent("Where is my order?")},
+		},
+	})
+
+	var content string
+	for chunk := range respChan {
+		content += chunk.Choices[0].Delta.Content.Text()
+	}
+	require.NoError(t, <-errChan)
+	assert.Equal(t, "Your order shipped.", content)
+}
+
+func TestCreateFIMCompletion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/fim/completions", r.URL.Path)
+
+		var req FIMCompletionRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "codestral-latest", req.Model)
+		assert.Equal(t, "def add(a, b):\n", req.Prompt)
+		assert.Equal(t, "\nprint(add(1, 2))", req.Suffix)
+		require.NotNil(t, req.MinTokens)
+		assert.Equal(t, 1, *req.MinTokens)
+		assert.Equal(t, []string{"\n\n"}, req.Stop)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ChatCompletionResponse{
+			ID:    "fim-1",
+			Model: "codestral-latest",
+			Choices: []ChatCompletionChoice{
+				{Message: ChatMessage{Role: RoleAssistant, Content: TextContent("    return a + b")}, FinishReason: "stop"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	minTokens := 1
+	resp, err := client.CreateFIMCompletion(context.Background(), &FIMCompletionRequest{
+		Model:     "codestral-latest",
+		Prompt:    "def add(a, b):\n",
+		Suffix:    "\nprint(add(1, 2))",
+		MinTokens: &minTokens,
+		Stop:      []string{"\n\n"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "    return a + b", resp.Choices[0].Message.Content.Text())
+}
+
+func TestCreateFIMCompletionStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/fim/completions", r.URL.Path)
+
+		var req FIMCompletionRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.True(t, req.Stream)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, content := range []string{"    return", " a + b"} {
+			data, _ := json.Marshal(ChatCompletionStreamResponse{
+				Choices: []ChatCompletionChoice{{Delta: &ChatMessage{Content: TextContent(content)}}},
+			})
+			w.Write([]byte("data: " + string(data) + "\n\n"))
+		}
+		w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	respChan, errChan := client.CreateFIMCompletionStream(context.Background(), &FIMCompletionRequest{
+		Model:  "codestral-latest",
+		Prompt: "def add(a, b):\n",
+	})
+
+	var content string
+	for chunk := range respChan {
+		content += chunk.Choices[0].Delta.Content.Text()
+	}
+	require.NoError(t, <-errChan)
+	assert.Equal(t, "    return a + b", content)
+}
+
+func TestCreateFineTuningJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/jobs", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"model": "open-mistral-nemo",
+			"training_files": [{"file_id": "file-1"}],
+			"validation_files": ["file-2"],
+			"auto_start": false,
+			"hyperparameters": {"training_steps": 10, "learning_rate": 0.0001},
+			"integrations": [{"project": "ft", "api_key": "wandb-key"}]
+		}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "job-123", "object": "job", "job_type": "completion", "auto_start": false,
+			"model": "open-mistral-nemo", "status": "QUEUED", "created_at": 1700000000, "modified_at": 1700000000,
+			"training_files": ["file-1"], "hyperparameters": {"training_steps": 10, "learning_rate": 0.0001},
+			"repositories": []
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	steps := 10
+	learningRate := 0.0001
+	autoStart := false
+	job, err := client.CreateFineTuningJob(context.Background(), &FineTuningJobRequest{
+		Model:           "open-mistral-nemo",
+		TrainingFiles:   []TrainingFile{{FileID: "file-1"}},
+		ValidationFiles: []string{"file-2"},
+		AutoStart:       &autoStart,
+		Hyperparameters: TrainingParameters{
+			TrainingSteps: &steps,
+			LearningRate:  &learningRate,
+		},
+		Integrations: []WandbIntegration{{Project: "ft", APIKey: "wandb-key"}},
+	})
+
+	require.NoError(t, err)
+	completionJob, ok := job.(*CompletionJob)
+	require.True(t, ok, "job should be a *CompletionJob")
+	assert.Equal(t, "job-123", completionJob.ID)
+	assert.Equal(t, FineTuningJobStatusQueued, job.Details().Status)
+	assert.Equal(t, 10, *completionJob.Hyperparameters.TrainingSteps)
+}
+
+func TestListFineTuningJobs(t *testing.T) {
+	createdAfter := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/jobs", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "1", query.Get("page"))
+		assert.Equal(t, "open-mistral-nemo", query.Get("model"))
+		assert.Equal(t, "2025-01-02T03:04:05Z", query.Get("created_after"))
+		assert.Equal(t, "true", query.Get("created_by_me"))
+		assert.Equal(t, "RUNNING", query.Get("status"))
+		assert.Equal(t, "my-project", query.Get("wandb_project"))
+		assert.False(t, query.Has("created_before"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"object": "list", "total": 2,
+			"data": [
+				{"id": "job-1", "job_type": "completion", "status": "RUNNING", "model": "open-mistral-nemo", "training_files": [], "hyperparameters": {}},
+				{"id": "job-2", "job_type": "classifier", "status": "RUNNING", "model": "ministral-3b-latest", "training_files": [], "hyperparameters": {},
+				 "classifier_targets": [{"name": "intent", "labels": ["buy", "sell"], "weight": 1, "loss_function": "single_class"}]}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListFineTuningJobs(context.Background(), &ListFineTuningJobsParams{
+		Page:         1,
+		Model:        "open-mistral-nemo",
+		CreatedAfter: createdAfter,
+		CreatedByMe:  true,
+		Status:       FineTuningJobStatusRunning,
+		WandbProject: "my-project",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Total)
+	require.Len(t, resp.Data, 2)
+	assert.IsType(t, &CompletionJob{}, resp.Data[0])
+	classifierJob, ok := resp.Data[1].(*ClassifierJob)
+	require.True(t, ok, "job should be a *ClassifierJob")
+	assert.Equal(t, []string{"buy", "sell"}, classifierJob.ClassifierTargets[0].Labels)
+	assert.Equal(t, ClassifierLossFunctionSingleClass, classifierJob.ClassifierTargets[0].LossFunction)
+}
+
+func TestGetFineTuningJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/jobs/job-123", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "job-123", "job_type": "completion", "status": "SUCCESS", "model": "open-mistral-nemo",
+			"fine_tuned_model": "ft:open-mistral-nemo:abc", "training_files": ["file-1"], "hyperparameters": {},
+			"events": [{"name": "status-updated", "data": {"status": "SUCCESS"}, "created_at": 1700000100}],
+			"checkpoints": [{"metrics": {"train_loss": 0.5, "valid_loss": 0.6}, "step_number": 10, "created_at": 1700000050}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	job, err := client.GetFineTuningJob(context.Background(), "job-123")
+
+	require.NoError(t, err)
+	details := job.Details()
+	assert.Equal(t, "ft:open-mistral-nemo:abc", details.FineTunedModel)
+	require.Len(t, details.Events, 1)
+	assert.Equal(t, "status-updated", details.Events[0].Name)
+	require.Len(t, details.Checkpoints, 1)
+	assert.Equal(t, 10, details.Checkpoints[0].StepNumber)
+	assert.Equal(t, 0.5, *details.Checkpoints[0].Metrics.TrainLoss)
+}
+
+func TestCancelFineTuningJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/jobs/job-123/cancel", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "job-123", "job_type": "classifier", "status": "CANCELLATION_REQUESTED", "training_files": [], "hyperparameters": {}, "classifier_targets": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	job, err := client.CancelFineTuningJob(context.Background(), "job-123")
+
+	require.NoError(t, err)
+	assert.IsType(t, &ClassifierJob{}, job)
+	assert.Equal(t, FineTuningJobStatusCancellationRequested, job.Details().Status)
+}
+
+func TestStartFineTuningJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/jobs/job-123/start", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "job-123", "job_type": "completion", "status": "STARTED", "training_files": [], "hyperparameters": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	job, err := client.StartFineTuningJob(context.Background(), "job-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, FineTuningJobStatusStarted, job.Details().Status)
+}
+
+func TestGetModelFineTunedCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/models/ft:open-mistral-7b:abc", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "ft:open-mistral-7b:abc", "object": "model", "type": "fine-tuned",
+			"job": "job-123", "root": "open-mistral-7b", "archived": true,
+			"capabilities": {"completion_chat": true, "completion_fim": false, "function_calling": true, "fine_tuning": false, "vision": false, "classification": false}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetModel(context.Background(), "ft:open-mistral-7b:abc")
+
+	require.NoError(t, err)
+	assert.Equal(t, "job-123", resp.Job)
+	assert.Equal(t, "open-mistral-7b", resp.Root)
+	assert.True(t, resp.Archived)
+	assert.True(t, resp.Capabilities.CompletionChat)
+	assert.True(t, resp.Capabilities.FunctionCalling)
+	assert.False(t, resp.Capabilities.Vision)
+}
+
+func TestUpdateFineTunedModel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/models/ft:open-mistral-7b:abc", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "support-bot", "description": "v2"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ft:open-mistral-7b:abc", "name": "support-bot", "description": "v2", "job": "job-123", "root": "open-mistral-7b", "capabilities": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UpdateFineTunedModel(context.Background(), "ft:open-mistral-7b:abc", &UpdateFineTunedModelRequest{
+		Name:        "support-bot",
+		Description: "v2",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "support-bot", resp.Name)
+	assert.Equal(t, "v2", resp.Description)
+}
+
+func TestArchiveModel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/models/ft:open-mistral-7b:abc/archive", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ft:open-mistral-7b:abc", "object": "model", "archived": true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ArchiveModel(context.Background(), "ft:open-mistral-7b:abc")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Archived)
+}
+
+func TestUnarchiveModel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/fine_tuning/models/ft:open-mistral-7b:abc/archive", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "ft:open-mistral-7b:abc", "object": "model", "archived": false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UnarchiveModel(context.Background(), "ft:open-mistral-7b:abc")
+
+	require.NoError(t, err)
+	assert.False(t, resp.Archived)
+}
+
+func TestCreateBatchJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/batch/jobs", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"input_files": ["file-1"],
+			"endpoint": "/v1/chat/completions",
+			"model": "mistral-small-latest",
+			"metadata": {"pipeline": "nightly"},
+			"timeout_hours": 48
+		}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "b-123", "object": "batch", "input_files": ["file-1"], "endpoint": "/v1/chat/completions",
+			"model": "mistral-small-latest", "metadata": {"pipeline": "nightly"}, "errors": [], "status": "QUEUED",
+			"created_at": 1700000000, "total_requests": 0, "completed_requests": 0, "succeeded_requests": 0, "failed_requests": 0
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateBatchJob(context.Background(), &BatchJobRequest{
+		InputFiles:   []string{"file-1"},
+		Endpoint:     BatchEndpointChatCompletions,
+		Model:        "mistral-small-latest",
+		Metadata:     map[string]string{"pipeline": "nightly"},
+		TimeoutHours: 48,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "b-123", resp.ID)
+	assert.Equal(t, BatchJobStatusQueued, resp.Status)
+	assert.Equal(t, BatchEndpointChatCompletions, resp.Endpoint)
+	assert.Nil(t, resp.StartedAt)
+}
+
+func TestListBatchJobs(t *testing.T) {
+	createdAfter := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/batch/jobs", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "2", query.Get("page_size"))
+		assert.Equal(t, "2025-01-02T03:04:05Z", query.Get("created_after"))
+		assert.Equal(t, "true", query.Get("created_by_me"))
+		assert.Equal(t, []string{"QUEUED", "RUNNING"}, query["status"])
+		assert.False(t, query.Has("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"object": "list", "total": 1,
+			"data": [{"id": "b-1", "input_files": ["file-1"], "endpoint": "/v1/embeddings", "errors": [], "status": "RUNNING",
+			          "created_at": 1700000000, "total_requests": 10, "completed_requests": 4, "succeeded_requests": 3, "failed_requests": 1,
+			          "started_at": 1700000100}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListBatchJobs(context.Background(), &ListBatchJobsParams{
+		PageSize:     2,
+		CreatedAfter: createdAfter,
+		CreatedByMe:  true,
+		Status:       []BatchJobStatus{BatchJobStatusQueued, BatchJobStatusRunning},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, 4, resp.Data[0].CompletedRequests)
+	require.NotNil(t, resp.Data[0].StartedAt)
+	assert.Equal(t, int64(1700000100), *resp.Data[0].StartedAt)
+}
+
+func TestGetBatchJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/batch/jobs/b-123", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "b-123", "input_files": ["file-1"], "endpoint": "/v1/chat/completions", "status": "SUCCESS",
+			"output_file": "file-out", "error_file": "file-err",
+			"errors": [{"message": "invalid request body", "count": 2}],
+			"created_at": 1700000000, "total_requests": 10, "completed_requests": 10, "succeeded_requests": 8, "failed_requests": 2,
+			"started_at": 1700000100, "completed_at": 1700000900
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetBatchJob(context.Background(), "b-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, BatchJobStatusSuccess, resp.Status)
+	assert.Equal(t, "file-out", resp.OutputFile)
+	assert.Equal(t, "file-err", resp.ErrorFile)
+	assert.Equal(t, []BatchError{{Message: "invalid request body", Count: 2}}, resp.Errors)
+	require.NotNil(t, resp.CompletedAt)
+	assert.Equal(t, int64(1700000900), *resp.CompletedAt)
+}
+
+func TestCancelBatchJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/batch/jobs/b-123/cancel", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "b-123", "input_files": [], "endpoint": "/v1/chat/completions", "errors": [], "status": "CANCELLATION_REQUESTED", "created_at": 1700000000}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CancelBatchJob(context.Background(), "b-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, BatchJobStatusCancellationRequested, resp.Status)
+}
+
+func TestCreateModeration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/moderations", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"model": "mistral-moderation-latest", "input": ["hello", "my SSN is 123-45-6789"]}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "mod-123", "model": "mistral-moderation-latest",
+			"results": [
+				{"categories": {"pii": false, "sexual": false}, "category_scores": {"pii": 0.01, "sexual": 0.001}},
+				{"categories": {"pii": true, "law": true, "sexual": false}, "category_scores": {"pii": 0.98, "law": 0.6, "sexual": 0.001}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateModeration(context.Background(), &ModerationRequest{
+		Model: "mistral-moderation-latest",
+		Input: []string{"hello", "my SSN is 123-45-6789"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "mod-123", resp.ID)
+	require.Len(t, resp.Results, 2)
+	assert.False(t, resp.Results[0].Flagged())
+	assert.True(t, resp.Results[1].Flagged())
+	assert.Equal(t, []ModerationCategory{ModerationCategoryLaw, ModerationCategoryPII}, resp.Results[1].FlaggedCategories())
+	assert.Equal(t, 0.98, resp.Results[1].CategoryScores[ModerationCategoryPII])
+}
+
+func TestCreateChatModeration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/chat/moderations", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"model": "mistral-moderation-latest", "input": [{"role": "user", "content": "How do I pick a lock?"}]}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "mod-456", "model": "mistral-moderation-latest",
+			"results": [{"categories": {"dangerous_and_criminal_content": true}, "category_scores": {"dangerous_and_criminal_content": 0.87}}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateChatModeration(context.Background(), &ChatModerationRequest{
+		Model: "mistral-moderation-latest",
+		Input: []ChatMessage{{Role: RoleUser, Content: TextContent("How do I pick a lock?")}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	assert.True(t, resp.Results[0].Categories[ModerationCategoryDangerousAndCriminalContent])
+}
+
+func TestCreateClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/classifications", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"model": "ft:ministral-3b-latest:intent", "input": "cancel my plan"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "cls-123", "model": "ft:ministral-3b-latest:intent",
+			"results": [{"intent": {"scores": {"cancel": 0.91, "upgrade": 0.06, "other": 0.03}}}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateClassification(context.Background(), &ClassificationRequest{
+		Model: "ft:ministral-3b-latest:intent",
+		Input: "cancel my plan",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	label, score := resp.Results[0]["intent"].Top()
+	assert.Equal(t, "cancel", label)
+	assert.Equal(t, 0.91, score)
+}
+
+func TestCreateChatClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/chat/classifications", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"model": "ft:ministral-3b-latest:intent", "input": {"messages": [{"role": "user", "content": "Where is my order?"}]}}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "cls-456", "model": "ft:ministral-3b-latest:intent",
+			"results": [{"intent": {"scores": {"tracking": 0.8, "cancel": 0.2}}, "sentiment": {"scores": {"negative": 0.7, "positive": 0.3}}}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateChatClassification(context.Background(), &ChatClassificationRequest{
+		Model: "ft:ministral-3b-latest:intent",
+		Input: ClassificationChat{
+			Messages: []ChatMessage{{Role: RoleUser, Content: TextContent("Where is my order?")}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, 0.7, resp.Results[0]["sentiment"].Scores["negative"])
+	label, _ := resp.Results[0]["intent"].Top()
+	assert.Equal(t, "tracking", label)
+}
+
+func TestProcessOCR(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/ocr", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"model": "mistral-ocr-latest",
+			"document": {"type": "document_url", "document_url": "https://example.com/invoice.pdf"},
+			"pages": [0, 1],
+			"include_image_base64": true,
+			"image_limit": 5,
+			"document_annotation_format": {
+				"type": "json_schema",
+				"json_schema": {"name": "invoice", "schema": {"type": "object"}, "strict": true}
+			}
+		}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"model": "mistral-ocr-latest",
+			"pages": [{
+				"index": 0, "markdown": "# Invoice\n\n![img-0.jpeg](img-0.jpeg)",
+				"images": [{"id": "img-0.jpeg", "top_left_x": 10, "top_left_y": 20, "bottom_right_x": 110, "bottom_right_y": 220, "image_base64": "data:image/jpeg;base64,AAAA"}],
+				"dimensions": {"dpi": 200, "height": 2200, "width": 1700}
+			}],
+			"document_annotation": "{\"total\": 42}",
+			"usage_info": {"pages_processed": 1, "doc_size_bytes": 12345}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ProcessOCR(context.Background(), &OCRRequest{
+		Model:              "mistral-ocr-latest",
+		Document:           DocumentURLChunk{DocumentURL: "https://example.com/invoice.pdf"},
+		Pages:              []int{0, 1},
+		IncludeImageBase64: true,
+		ImageLimit:         5,
+		DocumentAnnotationFormat: &ResponseFormat{
+			Type: "json_schema",
+			JSONSchema: &JSONSchema{
+				Name:   "invoice",
+				Schema: map[string]interface{}{"type": "object"},
+				Strict: true,
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Pages, 1)
+	page := resp.Pages[0]
+	assert.Equal(t, "# Invoice\n\n![img-0.jpeg](img-0.jpeg)", page.Markdown)
+	require.Len(t, page.Images, 1)
+	assert.Equal(t, "img-0.jpeg", page.Images[0].ID)
+	require.NotNil(t, page.Images[0].BottomRightY)
+	assert.Equal(t, 220, *page.Images[0].BottomRightY)
+	require.NotNil(t, page.Dimensions)
+	assert.Equal(t, 200, page.Dimensions.DPI)
+	assert.Equal(t, `{"total": 42}`, resp.DocumentAnnotation)
+	assert.Equal(t, 1, resp.UsageInfo.PagesProcessed)
+}
+
+func TestCreateTranscription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/audio/transcriptions", r.URL.Path)
+		assert.Contains(t, r.Header.Get("Content-Type"), "multipart/form-data")
+
+		require.NoError(t, r.ParseMultipartForm(10<<20))
+		assert.Equal(t, "voxtral-mini-latest", r.FormValue("model"))
+		assert.Equal(t, "en", r.FormValue("language"))
+		assert.Equal(t, "0.2", r.FormValue("temperature"))
+		assert.Equal(t, []string{"segment"}, r.MultipartForm.Value["timestamp_granularities"])
+		assert.Empty(t, r.FormValue("stream"))
+		assert.Empty(t, r.FormValue("file_url"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "call.mp3", header.Filename)
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "audio-bytes", string(data))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"model": "voxtral-mini-latest", "text": "Hello. Thanks for calling.", "language": "en",
+			"segments": [
+				{"type": "transcription_segment", "text": "Hello.", "start": 0.0, "end": 1.2},
+				{"type": "transcription_segment", "text": "Thanks for calling.", "start": 1.2, "end": 3.5}
+			],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 6, "total_tokens": 16, "prompt_audio_seconds": 4}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	temperature := 0.2
+	resp, err := client.CreateTranscription(context.Background(), &TranscriptionRequest{
+		Model:                  "voxtral-mini-latest",
+		File:                   strings.NewReader("audio-bytes"),
+		Filename:               "call.mp3",
+		Language:               "en",
+		Temperature:            &temperature,
+		TimestampGranularities: []TimestampGranularity{TimestampGranularitySegment},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello. Thanks for calling.", resp.Text)
+	require.Len(t, resp.Segments, 2)
+	assert.Equal(t, 3.5, resp.Segments[1].End)
+	assert.Equal(t, 16, resp.Usage.TotalTokens)
+	require.NotNil(t, resp.Usage.PromptAudioSeconds)
+	assert.Equal(t, 4, *resp.Usage.PromptAudioSeconds)
+}
+
+func TestCreateTranscriptionStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/audio/transcriptions", r.URL.Path)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		require.NoError(t, r.ParseMultipartForm(10<<20))
+		assert.Equal(t, "true", r.FormValue("stream"))
+		assert.Equal(t, "https://example.com/call.mp3", r.FormValue("file_url"))
+		_, _, err := r.FormFile("file")
+		assert.Equal(t, http.ErrMissingFile, err)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("event: transcription.language\ndata: {\"type\": \"transcription.language\", \"audio_language\": \"en\"}\n\n"))
+		w.Write([]byte("event: transcription.text.delta\ndata: {\"type\": \"transcription.text.delta\", \"text\": \"Hello.\"}\n\n"))
+		w.Write([]byte("event: transcription.segment\ndata: {\"type\": \"transcription.segment\", \"text\": \"Hello.\", \"start\": 0, \"end\": 1.2}\n\n"))
+		w.Write([]byte("event: transcription.unknown\ndata: {}\n\n"))
+		w.Write([]byte("event: transcription.done\ndata: {\"type\": \"transcription.done\", \"model\": \"voxtral-mini-latest\", \"text\": \"Hello.\", \"language\": \"en\", \"usage\": {\"total_tokens\": 7}}\n\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	eventChan, errChan := client.CreateTranscriptionStream(context.Background(), &TranscriptionRequest{
+		Model:   "voxtral-mini-latest",
+		FileURL: "https://example.com/call.mp3",
+	})
+
+	var events []TranscriptionEvent
+	for event := range eventChan {
+		events = append(events, event)
+	}
+	require.NoError(t, <-errChan)
+
+	require.Len(t, events, 4)
+	assert.Equal(t, "en", events[0].(*TranscriptionLanguageEvent).AudioLanguage)
+	assert.Equal(t, "Hello.", events[1].(*TranscriptionTextDeltaEvent).Text)
+	assert.Equal(t, 1.2, events[2].(*TranscriptionSegmentEvent).End)
+	done := events[3].(*TranscriptionDoneEvent)
+	assert.Equal(t, TranscriptionEventDone, done.Type)
+	assert.Equal(t, "Hello.", done.Text)
+	assert.Equal(t, 7, done.Usage.TotalTokens)
+}
+
+func TestCreateTranscriptionRequiresOneSource(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	for _, req := range []*TranscriptionRequest{
+		{Model: "voxtral-mini-latest"},
+		{Model: "voxtral-mini-latest", File: strings.NewReader("audio"), Filename: "call.mp3", FileURL: "https://example.com/call.mp3"},
+		{Model: "voxtral-mini-latest", FileURL: "https://example.com/call.mp3", FileID: "file-1"},
+	} {
+		_, err := client.CreateTranscription(context.Background(), req)
+		assert.ErrorContains(t, err, "exactly one of File, FileURL or FileID")
+
+		eventChan, errChan := client.CreateTranscriptionStream(context.Background(), req)
+		for range eventChan {
+			t.Error("unexpected event")
+		}
+		assert.ErrorContains(t, <-errChan, "exactly one of File, FileURL or FileID")
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+}
+
+const testLibraryJSON = `{
+	"id": "lib-1", "name": "Support handbook", "created_at": "2025-01-02T03:04:05Z", "updated_at": "2025-01-02T03:04:05Z",
+	"owner_id": "user-1", "owner_type": "User", "total_size": 2048, "nb_documents": 3, "chunk_size": 512,
+	"description": "Internal procedures"
+}`
+
+const testLibraryDocumentJSON = `{
+	"id": "doc-1", "library_id": "lib-1", "hash": "abc", "mime_type": "application/pdf", "extension": "pdf",
+	"size": 1024, "name": "handbook.pdf", "created_at": "2025-01-02T03:04:05Z", "processing_status": "Running",
+	"uploaded_by_id": "user-1", "uploaded_by_type": "User", "tokens_processing_total": 0
+}`
+
+func TestCreateLibrary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/libraries", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "Support handbook", "description": "Internal procedures", "chunk_size": 512}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(testLibraryJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	chunkSize := 512
+	resp, err := client.CreateLibrary(context.Background(), &LibraryRequest{
+		Name:        "Support handbook",
+		Description: "Internal procedures",
+		ChunkSize:   &chunkSize,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "lib-1", resp.ID)
+	assert.Equal(t, 3, resp.NbDocuments)
+	assert.Equal(t, int64(2048), resp.TotalSize)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), resp.CreatedAt)
+}
+
+func TestListLibraries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [` + testLibraryJSON + `]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListLibraries(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "Support handbook", resp[0].Name)
+}
+
+func TestGetLibrary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testLibraryJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetLibrary(context.Background(), "lib-1")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.ChunkSize)
+	assert.Equal(t, 512, *resp.ChunkSize)
+}
+
+func TestUpdateLibrary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"description": "Internal procedures"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testLibraryJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UpdateLibrary(context.Background(), "lib-1", &LibraryUpdateRequest{Description: "Internal procedures"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Internal procedures", resp.Description)
+}
+
+func TestDeleteLibrary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testLibraryJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.DeleteLibrary(context.Background(), "lib-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "lib-1", resp.ID)
+}
+
+func TestUploadLibraryDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents", r.URL.Path)
+		assert.Contains(t, r.Header.Get("Content-Type"), "multipart/form-data")
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "handbook.pdf", header.Filename)
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "%PDF-1.7", string(data))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(testLibraryDocumentJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UploadLibraryDocument(context.Background(), "lib-1", &UploadLibraryDocumentRequest{
+		File:     strings.NewReader("%PDF-1.7"),
+		Filename: "handbook.pdf",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "doc-1", resp.ID)
+	assert.Equal(t, DocumentProcessingStatusRunning, resp.ProcessingStatus)
+}
+
+func TestListLibraryDocuments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "handbook", query.Get("search"))
+		assert.Equal(t, "10", query.Get("page_size"))
+		assert.Equal(t, "name", query.Get("sort_by"))
+		assert.Equal(t, "asc", query.Get("sort_order"))
+		assert.False(t, query.Has("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"pagination": {"total_items": 1, "total_pages": 1, "current_page": 0, "page_size": 10, "has_more": false},
+			"data": [` + testLibraryDocumentJSON + `]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListLibraryDocuments(context.Background(), "lib-1", &ListLibraryDocumentsParams{
+		Search:    "handbook",
+		PageSize:  10,
+		SortBy:    "name",
+		SortOrder: "asc",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Pagination.TotalItems)
+	assert.False(t, resp.Pagination.HasMore)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "handbook.pdf", resp.Data[0].Name)
+}
+
+func TestGetLibraryDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testLibraryDocumentJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetLibraryDocument(context.Background(), "lib-1", "doc-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", resp.MimeType)
+	assert.Nil(t, resp.LastProcessedAt)
+}
+
+func TestUpdateLibraryDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "handbook.pdf"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testLibraryDocumentJSON))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UpdateLibraryDocument(context.Background(), "lib-1", "doc-1", &LibraryDocumentUpdateRequest{Name: "handbook.pdf"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "handbook.pdf", resp.Name)
+}
+
+func TestDeleteLibraryDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1", r.URL.Path)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	err := client.DeleteLibraryDocument(context.Background(), "lib-1", "doc-1")
+
+	require.NoError(t, err)
+}
+
+func TestGetLibraryDocumentTextContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1/text_content", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"text": "Chapter 1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetLibraryDocumentTextContent(context.Background(), "lib-1", "doc-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Chapter 1", resp.Text)
+}
+
+func TestGetLibraryDocumentStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1/status", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"document_id": "doc-1", "processing_status": "Completed"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.GetLibraryDocumentStatus(context.Background(), "lib-1", "doc-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "doc-1", resp.DocumentID)
+	assert.Equal(t, DocumentProcessingStatusCompleted, resp.ProcessingStatus)
+}
+
+func TestGetLibraryDocumentSignedURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/libraries/lib-1/documents/doc-1/signed-url":
+			w.Write([]byte(`"https://storage.example.com/original?sig=1"`))
+		case "/v1/libraries/lib-1/documents/doc-1/extracted-text-signed-url":
+			w.Write([]byte(`"https://storage.example.com/text?sig=2"`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	original, err := client.GetLibraryDocumentSignedURL(context.Background(), "lib-1", "doc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://storage.example.com/original?sig=1", original)
+
+	text, err := client.GetLibraryDocumentExtractedTextSignedURL(context.Background(), "lib-1", "doc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://storage.example.com/text?sig=2", text)
+}
+
+func TestReprocessLibraryDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1/reprocess", r.URL.Path)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	err := client.ReprocessLibraryDocument(context.Background(), "lib-1", "doc-1")
+
+	require.NoError(t, err)
+}
+
+func TestListLibrarySharing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/share", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [
+			{"library_id": "lib-1", "org_id": "org-1", "role": "Owner", "share_with_type": "User", "share_with_uuid": "user-1"},
+			{"library_id": "lib-1", "org_id": "org-1", "role": "Viewer", "share_with_type": "Workspace", "share_with_uuid": "ws-1"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ListLibrarySharing(context.Background(), "lib-1")
+
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, EntityTypeWorkspace, resp[1].ShareWithType)
+	assert.Equal(t, "Viewer", resp[1].Role)
+}
+
+func TestShareLibrary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/share", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"org_id": "org-1", "level": "Editor", "share_with_uuid": "ws-1", "share_with_type": "Workspace"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"library_id": "lib-1", "user_id": "user-1", "org_id": "org-1", "role": "Editor", "share_with_type": "Workspace", "share_with_uuid": "ws-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.ShareLibrary(context.Background(), "lib-1", &SharingRequest{
+		OrgID:         "org-1",
+		Level:         ShareLevelEditor,
+		ShareWithUUID: "ws-1",
+		ShareWithType: EntityTypeWorkspace,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Editor", resp.Role)
+	assert.Equal(t, "user-1", resp.UserID)
+}
+
+func TestUnshareLibrary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/share", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"org_id": "org-1", "share_with_uuid": "user-2", "share_with_type": "User"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"library_id": "lib-1", "org_id": "org-1", "role": "Viewer", "share_with_type": "User", "share_with_uuid": "user-2"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.UnshareLibrary(context.Background(), "lib-1", &SharingDeleteRequest{
+		OrgID:         "org-1",
+		ShareWithUUID: "user-2",
+		ShareWithType: EntityTypeUser,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", resp.ShareWithUUID)
+}
+
+func TestWaitForDocumentProcessed(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/libraries/lib-1/documents/doc-1/status", r.URL.Path)
+
+		status := "Running"
+		if atomic.AddInt32(&polls, 1) == 3 {
+			status = "Completed"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"document_id": "doc-1", "processing_status": "` + status + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	status, err := client.WaitForDocumentProcessed(context.Background(), "lib-1", "doc-1", &PollOptions{
+		Interval:    time.Millisecond,
+		MaxInterval: 2 * time.Millisecond,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, DocumentProcessingStatusCompleted, status.ProcessingStatus)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&polls))
+}
+
+func TestWaitForDocumentProcessedFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"document_id": "doc-1", "processing_status": "Failed"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	status, err := client.WaitForDocumentProcessed(context.Background(), "lib-1", "doc-1", nil)
+
+	var procErr *DocumentProcessingError
+	require.ErrorAs(t, err, &procErr)
+	assert.Equal(t, "doc-1", procErr.Status.DocumentID)
+	assert.Equal(t, DocumentProcessingStatusFailed, status.ProcessingStatus)
+}
+
+func TestWaitForDocumentProcessedIgnoresStatusCase(t *testing.T) {
+	statuses := []string{"RUNNING", "COMPLETED"}
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"document_id": "doc-1", "processing_status": %q}`, statuses[n-1])
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	status, err := client.WaitForDocumentProcessed(ctx, "lib-1", "doc-1", &PollOptions{Interval: time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, DocumentProcessingStatus("COMPLETED"), status.ProcessingStatus)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+}
+
+func TestWaitForDocumentProcessedContextDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"document_id": "doc-1", "processing_status": "Running"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	status, err := client.WaitForDocumentProcessed(ctx, "lib-1", "doc-1", &PollOptions{Interval: time.Millisecond})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotNil(t, status)
+	assert.Equal(t, DocumentProcessingStatusRunning, status.ProcessingStatus)
+}
+
+func TestWaitForDocumentsProcessed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := "Completed"
+		if strings.Contains(r.URL.Path, "doc-2") {
+			status = "Failed"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"document_id": "` + strings.Split(r.URL.Path, "/")[5] + `", "processing_status": "` + status + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	results, err := client.WaitForDocumentsProcessed(context.Background(), "lib-1", []string{"doc-1", "doc-2", "doc-3"}, nil)
+
+	var procErr *DocumentProcessingError
+	require.ErrorAs(t, err, &procErr)
+	assert.Equal(t, "doc-2", procErr.Status.DocumentID)
+
+	require.Len(t, results, 3)
+	for i, id := range []string{"doc-1", "doc-2", "doc-3"} {
+		assert.Equal(t, id, results[i].DocumentID)
+		assert.Equal(t, id, results[i].Status.DocumentID)
+	}
+	assert.NoError(t, results[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.NoError(t, results[2].Err)
+}
+
+func TestWaitForDocumentsProcessedLimitsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight, polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+
+		// Every other poll completes the document it polls.
+		status := "Running"
+		if atomic.AddInt32(&polls, 1)%2 == 0 {
+			status = "Completed"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"document_id": "` + strings.Split(r.URL.Path, "/")[5] + `", "processing_status": "` + status + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	documentIDs := make([]string, 20)
+	for i := range documentIDs {
+		documentIDs[i] = fmt.Sprintf("doc-%d", i)
+	}
+	results, err := client.WaitForDocumentsProcessed(context.Background(), "lib-1", documentIDs, &PollOptions{
+		Interval:       time.Millisecond,
+		MaxConcurrency: 3,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 20)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+}
+
+func TestWaitForBatchJob(t *testing.T) {
+	responses := []string{
+		`{"id": "batch-1", "status": "QUEUED", "total_requests": 4, "completed_requests": 0}`,
+		`{"id": "batch-1", "status": "RUNNING", "total_requests": 4, "completed_requests": 2}`,
+		`{"id": "batch-1", "status": "RUNNING", "total_requests": 4, "completed_requests": 2}`,
+		`{"id": "batch-1", "status": "SUCCESS", "total_requests": 4, "completed_requests": 4, "output_file": "file-out"}`,
+	}
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/batch/jobs/batch-1", r.URL.Path)
+
+		n := atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(responses[n-1]))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	var seen []BatchJobStatus
+	job, err := client.WaitForBatchJob(context.Background(), "batch-1", &PollOptions{
+		Interval:    time.Millisecond,
+		MaxInterval: time.Millisecond,
+		Jitter:      0.5,
+	}, func(job *BatchJob) {
+		seen = append(seen, job.Status)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, BatchJobStatusSuccess, job.Status)
+	assert.Equal(t, "file-out", job.OutputFile)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&polls))
+	assert.Equal(t, []BatchJobStatus{BatchJobStatusQueued, BatchJobStatusRunning, BatchJobStatusSuccess}, seen)
+}
+
+func TestWaitForBatchJobFailedIsNotAnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "batch-1", "status": "TIMEOUT_EXCEEDED"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	job, err := client.WaitForBatchJob(context.Background(), "batch-1", nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, BatchJobStatusTimeoutExceeded, job.Status)
+}
+
+func TestWaitForFineTuningJob(t *testing.T) {
+	responses := []string{
+		`{"id": "job-1", "job_type": "completion", "status": "RUNNING", "training_files": [], "hyperparameters": {},
+			"events": [{"name": "status-updated", "data": {"status": "RUNNING"}, "created_at": 1}]}`,
+		`{"id": "job-1", "job_type": "completion", "status": "RUNNING", "training_files": [], "hyperparameters": {},
+			"events": [{"name": "status-updated", "data": {"status": "RUNNING"}, "created_at": 1}],
+			"checkpoints": [{"metrics": {"train_loss": 0.5}, "step_number": 10, "created_at": 2}]}`,
+		`{"id": "job-1", "job_type": "completion", "status": "SUCCESS", "training_files": [], "hyperparameters": {},
+			"fine_tuned_model": "ft:open-mistral-nemo:abc",
+			"events": [{"name": "status-updated", "data": {"status": "RUNNING"}, "created_at": 1},
+				{"name": "status-updated", "data": {"status": "SUCCESS"}, "created_at": 3}],
+			"checkpoints": [{"metrics": {"train_loss": 0.5}, "step_number": 10, "created_at": 2}]}`,
+	}
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/fine_tuning/jobs/job-1", r.URL.Path)
+
+		n := atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(responses[n-1]))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	var updates []FineTuningJobUpdate
+	job, err := client.WaitForFineTuningJob(context.Background(), "job-1", &PollOptions{Interval: time.Millisecond}, func(u FineTuningJobUpdate) {
+		updates = append(updates, u)
+	})
+
+	require.NoError(t, err)
+	completion, ok := job.(*CompletionJob)
+	require.True(t, ok)
+	assert.Equal(t, FineTuningJobStatusSuccess, completion.Status)
+
+	require.Len(t, updates, 3)
+	assert.True(t, updates[0].StatusChanged)
+	assert.Len(t, updates[0].NewEvents, 1)
+	assert.Empty(t, updates[0].NewCheckpoints)
+
+	assert.False(t, updates[1].StatusChanged)
+	assert.Empty(t, updates[1].NewEvents)
+	require.Len(t, updates[1].NewCheckpoints, 1)
+	assert.Equal(t, 10, updates[1].NewCheckpoints[0].StepNumber)
+
+	assert.True(t, updates[2].StatusChanged)
+	require.Len(t, updates[2].NewEvents, 1)
+	assert.Equal(t, int64(3), updates[2].NewEvents[0].CreatedAt)
+	assert.Empty(t, updates[2].NewCheckpoints)
+}
+
+func TestFineTuningJobTrackerSameSecondEvents(t *testing.T) {
+	first := FineTuningJobEvent{Name: "metrics", Data: map[string]interface{}{"step": 1.0}, CreatedAt: 5}
+	second := FineTuningJobEvent{Name: "metrics", Data: map[string]interface{}{"step": 2.0}, CreatedAt: 5}
+
+	var tracker fineTuningJobTracker
+	update, ok := tracker.update(&CompletionJob{FineTuningJobDetails: FineTuningJobDetails{Events: []FineTuningJobEvent{first}}})
+	require.True(t, ok)
+	assert.Equal(t, []FineTuningJobEvent{first}, update.NewEvents)
+
+	update, ok = tracker.update(&CompletionJob{FineTuningJobDetails: FineTuningJobDetails{Events: []FineTuningJobEvent{first, second, second}}})
+	require.True(t, ok)
+	assert.Equal(t, []FineTuningJobEvent{second, second}, update.NewEvents)
+
+	_, ok = tracker.update(&CompletionJob{FineTuningJobDetails: FineTuningJobDetails{Events: []FineTuningJobEvent{first, second, second}}})
+	assert.False(t, ok)
+}
+
+func TestAttachInlinesSmallFiles(t *testing.T) {
+	client := NewClient("test-api-key", WithBaseURL("http://127.0.0.1:0"))
+
+	chunk, err := client.Attach(context.Background(), bytes.NewReader([]byte("%PDF-1.7\n")), &AttachmentOptions{Name: "a.pdf"})
+
+	require.NoError(t, err)
+	document, ok := chunk.(DocumentURLChunk)
+	require.True(t, ok)
+	assert.Equal(t, "a.pdf", document.DocumentName)
+	assert.True(t, strings.HasPrefix(document.DocumentURL, "data:application/pdf;base64,"))
+}
+
+func TestAttachUploadsLargeDocuments(t *testing.T) {
+	content := append([]byte("%PDF-1.7\n"), bytes.Repeat([]byte{0}, 1024)...)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/files", r.URL.Path)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "ocr", r.FormValue("purpose"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "attachment.pdf", header.Filename)
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "file-123", "object": "file", "filename": "attachment.pdf", "purpose": "ocr"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	chunk, err := client.Attach(context.Background(), bytes.NewReader(content), &AttachmentOptions{MaxSize: 100})
+
+	require.NoError(t, err)
+	assert.Equal(t, FileChunk{FileID: "file-123"}, chunk)
+}
+
+func TestAttachRejectsLargeImages(t *testing.T) {
+	content := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1024)...)
+	client := NewClient("test-api-key", WithBaseURL("http://127.0.0.1:0"))
+
+	chunk, err := client.Attach(context.Background(), bytes.NewReader(content), &AttachmentOptions{MaxSize: 100})
+
+	var tooLarge *AttachmentTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, int64(100), tooLarge.MaxSize)
+	assert.Nil(t, chunk)
+}
+
+func TestAttachWrapsUploadErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "invalid file"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	content := append([]byte("%PDF-1.7\n"), bytes.Repeat([]byte{0}, 1024)...)
+
+	_, err := client.Attach(context.Background(), bytes.NewReader(content), &AttachmentOptions{MaxSize: 100})
+
+	assert.ErrorContains(t, err, "failed to upload attachment")
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
+
+func TestCreateChatCompletionReasoning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "reasoning", body["prompt_mode"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "cmpl-1", "model": "magistral-medium-latest", "choices": [{"index": 0, "finish_reason": "stop",
+			"message": {"role": "assistant", "content": [
+				{"type": "thinking", "thinking": [{"type": "text", "text": "2 + 2 is 4."}]},
+				{"type": "text", "text": "4"}
+			]}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	resp, err := client.CreateChatCompletion(context.Background(), &ChatCompletionRequest{
+		Model:      "magistral-medium-latest",
+		Messages:   []ChatMessage{{Role: RoleUser, Content: TextContent("What is 2 + 2?")}},
+		PromptMode: PromptModeReasoning,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2 + 2 is 4.", resp.Choices[0].Message.Content.Thinking())
+	assert.Equal(t, "4", resp.Choices[0].Message.Content.Text())
+}
+
+func TestCreateChatCompletionStreamReasoning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, content := range []string{
+			`[{"type": "thinking", "thinking": [{"type": "text", "text": "Adding "}]}]`,
+			`[{"type": "thinking", "thinking": [{"type": "text", "text": "numbers."}], "closed": true}]`,
+			`"The answer "`,
+			`[{"type": "text", "text": "is 4."}]`,
+		} {
+			w.Write([]byte(`data: {"id": "cmpl-1", "choices": [{"index": 0, "delta": {"content": ` + content + `}}]}` + "\n\n"))
+		}
+		w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	respChan, errChan := client.CreateChatCompletionStream(context.Background(), &ChatCompletionRequest{
+		Model:      "magistral-medium-latest",
+		Messages:   []ChatMessage{{Role: RoleUser, Content: TextContent("What is 2 + 2?")}},
+		PromptMode: PromptModeReasoning,
+	})
+
+	var thinking, answer string
+	for chunk := range respChan {
+		thinking += chunk.Choices[0].Delta.Content.Thinking()
+		answer += chunk.Choices[0].Delta.Content.Text()
+	}
+	require.NoError(t, <-errChan)
+	assert.Equal(t, "Adding numbers.", thinking)
+	assert.Equal(t, "The answer is 4.", answer)
+}
+
+type testSentiment struct {
+	Label      string  `json:"label" enum:"positive,neutral,negative"`
+	Confidence float64 `json:"confidence"`
+}
+
+func TestCreateStructuredCompletion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ChatCompletionRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.NotNil(t, req.ResponseFormat)
+		assert.Equal(t, "json_schema", req.ResponseFormat.Type)
+		assert.Equal(t, "testSentiment", req.ResponseFormat.JSONSchema.Name)
+		assert.True(t, req.ResponseFormat.JSONSchema.Strict)
+		assert.Equal(t, []interface{}{"label", "confidence"}, req.ResponseFormat.JSONSchema.Schema["required"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "cmpl-1", "choices": [{"index": 0, "finish_reason": "stop",
+			"message": {"role": "assistant", "content": "{\"label\": \"positive\", \"confidence\": 0.9}"}}],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	req := &ChatCompletionRequest{
+		Model:    "mistral-small-latest",
+		Messages: []ChatMessage{{Role: RoleUser, Content: TextContent("Great product!")}},
+	}
+	sentiment, resp, err := CreateStructuredCompletion[testSentiment](context.Background(), client, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, &testSentiment{Label: "positive", Confidence: 0.9}, sentiment)
+	assert.Equal(t, 15, resp.Usage.TotalTokens)
+	assert.Nil(t, req.ResponseFormat)
+}
+
+func TestCreateStructuredCompletionDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "cmpl-1", "choices": [{"index": 0, "finish_reason": "length",
+			"message": {"role": "assistant", "content": "{\"label\": \"posi"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	_, resp, err := CreateStructuredCompletion[testSentiment](context.Background(), client, &ChatCompletionRequest{
+		Model:    "mistral-small-latest",
+		Messages: []ChatMessage{{Role: RoleUser, Content: TextContent("Great product!")}},
+	})
+
+	var outErr *StructuredOutputError
+	require.ErrorAs(t, err, &outErr)
+	assert.Equal(t, `{"label": "posi`, outErr.Raw)
+	assert.Equal(t, "length", resp.Choices[0].FinishReason)
+}
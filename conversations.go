@@ -0,0 +1,940 @@
+package mistral
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EntryType identifies the kind of an entry stored in a conversation.
+// Every entry returned by the Conversations API carries a "type" field that
+// determines which concrete Go type it is decoded into.
+type EntryType string
+
+const (
+	// EntryTypeMessageInput is a message sent by the user (or injected by the caller).
+	EntryTypeMessageInput EntryType = "message.input"
+
+	// EntryTypeMessageOutput is a message generated by the model or an agent.
+	EntryTypeMessageOutput EntryType = "message.output"
+
+	// EntryTypeFunctionCall is a request from the model to call a client-side function.
+	EntryTypeFunctionCall EntryType = "function.call"
+
+	// EntryTypeFunctionResult is the result of a client-side function call, sent back
+	// to the conversation by the caller.
+	EntryTypeFunctionResult EntryType = "function.result"
+
+	// EntryTypeToolExecution is the record of a built-in connector (web search, code
+	// interpreter, etc.) executed on the server.
+	EntryTypeToolExecution EntryType = "tool.execution"
+
+	// EntryTypeAgentHandoff records control being handed from one agent to another.
+	EntryTypeAgentHandoff EntryType = "agent.handoff"
+)
+
+// ConversationEntry is implemented by every entry type that can appear in a conversation:
+// *MessageInputEntry, *MessageOutputEntry, *FunctionCallEntry, *FunctionResultEntry,
+// *ToolExecutionEntry and *AgentHandoffEntry. Entries decoded from API responses are
+// always pointers, so use a type switch on the pointer types to inspect them.
+type ConversationEntry interface {
+	// EntryType returns the discriminator value of the entry.
+	EntryType() EntryType
+}
+
+// ConversationEntries is an ordered list of conversation entries.
+// It knows how to decode the heterogeneous entry arrays returned by the API into
+// their concrete Go types based on each entry's "type" field.
+type ConversationEntries []ConversationEntry
+
+// UnmarshalJSON decodes a JSON array of entries, dispatching each element to the
+// concrete entry type named by its "type" field. Entries with an unknown type
+// result in an error.
+func (e *ConversationEntries) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	entries := make(ConversationEntries, 0, len(raw))
+	for _, item := range raw {
+		entry, err := unmarshalConversationEntry(item)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+
+	*e = entries
+	return nil
+}
+
+// unmarshalConversationEntry decodes a single entry into the concrete type named by its
+// "type" field.
+func unmarshalConversationEntry(data []byte) (ConversationEntry, error) {
+	var head struct {
+		Type EntryType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+
+	var entry ConversationEntry
+	switch head.Type {
+	case EntryTypeMessageInput:
+		entry = &MessageInputEntry{}
+	case EntryTypeMessageOutput:
+		entry = &MessageOutputEntry{}
+	case EntryTypeFunctionCall:
+		entry = &FunctionCallEntry{}
+	case EntryTypeFunctionResult:
+		entry = &FunctionResultEntry{}
+	case EntryTypeToolExecution:
+		entry = &ToolExecutionEntry{}
+	case EntryTypeAgentHandoff:
+		entry = &AgentHandoffEntry{}
+	default:
+		return nil, fmt.Errorf("unknown conversation entry type %q", head.Type)
+	}
+
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// MessageInputEntry is an input message inside a conversation, typically the user's prompt.
+type MessageInputEntry struct {
+	// Object is the object type, typically "entry".
+	Object string `json:"object,omitempty"`
+
+	// Type is the entry type. It is always "message.input" and is filled in automatically
+	// when the entry is encoded.
+	Type EntryType `json:"type,omitempty"`
+
+	// CreatedAt is the timestamp when the entry was created. Set by the server.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CompletedAt is the timestamp when the entry was completed. Set by the server.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// ID is the unique identifier of the entry. Set by the server.
+	ID string `json:"id,omitempty"`
+
+	// Role is the author of the message, either "user" or "assistant".
+	Role Role `json:"role"`
+
+	// Content is the message content: plain text, or chunks for multimodal messages.
+	Content Content `json:"content"`
+
+	// Prefix marks an assistant message as a prefix the model should continue from.
+	Prefix bool `json:"prefix,omitempty"`
+}
+
+// EntryType returns EntryTypeMessageInput.
+func (MessageInputEntry) EntryType() EntryType { return EntryTypeMessageInput }
+
+// MarshalJSON encodes the entry, setting the "type" field if it is empty.
+func (e MessageInputEntry) MarshalJSON() ([]byte, error) {
+	type alias MessageInputEntry
+	a := alias(e)
+	if a.Type == "" {
+		a.Type = EntryTypeMessageInput
+	}
+	return json.Marshal(a)
+}
+
+// MessageOutputEntry is a message produced by the model or an agent in a conversation.
+type MessageOutputEntry struct {
+	// Object is the object type, typically "entry".
+	Object string `json:"object,omitempty"`
+
+	// Type is the entry type. It is always "message.output" and is filled in automatically
+	// when the entry is encoded.
+	Type EntryType `json:"type,omitempty"`
+
+	// CreatedAt is the timestamp when the entry was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CompletedAt is the timestamp when the entry was completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// ID is the unique identifier of the entry.
+	ID string `json:"id,omitempty"`
+
+	// AgentID is the ID of the agent that produced the message, if any.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// Model is the model that produced the message, if any.
+	Model string `json:"model,omitempty"`
+
+	// Role is the author of the message, always "assistant".
+	Role Role `json:"role,omitempty"`
+
+	// Content is the message content: plain text, or chunks (text, images, tool
+	// references, etc.).
+	Content Content `json:"content"`
+}
+
+// EntryType returns EntryTypeMessageOutput.
+func (MessageOutputEntry) EntryType() EntryType { return EntryTypeMessageOutput }
+
+// MarshalJSON encodes the entry, setting the "type" field if it is empty.
+func (e MessageOutputEntry) MarshalJSON() ([]byte, error) {
+	type alias MessageOutputEntry
+	a := alias(e)
+	if a.Type == "" {
+		a.Type = EntryTypeMessageOutput
+	}
+	return json.Marshal(a)
+}
+
+// FunctionCallEntry is a request from the model to call one of the function tools
+// declared for the conversation. Execute the function and append a FunctionResultEntry
+// with the same ToolCallID to continue the conversation.
+type FunctionCallEntry struct {
+	// Object is the object type, typically "entry".
+	Object string `json:"object,omitempty"`
+
+	// Type is the entry type. It is always "function.call" and is filled in automatically
+	// when the entry is encoded.
+	Type EntryType `json:"type,omitempty"`
+
+	// CreatedAt is the timestamp when the entry was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CompletedAt is the timestamp when the entry was completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// ID is the unique identifier of the entry.
+	ID string `json:"id,omitempty"`
+
+	// ToolCallID is the identifier of this call, used to match it with its result.
+	ToolCallID string `json:"tool_call_id"`
+
+	// Name is the name of the function to call.
+	Name string `json:"name"`
+
+	// Arguments holds the JSON-encoded arguments for the function.
+	Arguments FunctionArguments `json:"arguments"`
+}
+
+// EntryType returns EntryTypeFunctionCall.
+func (FunctionCallEntry) EntryType() EntryType { return EntryTypeFunctionCall }
+
+// MarshalJSON encodes the entry, setting the "type" field if it is empty.
+func (e FunctionCallEntry) MarshalJSON() ([]byte, error) {
+	type alias FunctionCallEntry
+	a := alias(e)
+	if a.Type == "" {
+		a.Type = EntryTypeFunctionCall
+	}
+	return json.Marshal(a)
+}
+
+// FunctionArguments holds the JSON-encoded arguments of a function call.
+// The API may send arguments either as a JSON string or as a JSON object; both forms
+// are normalized to the JSON text of the arguments, matching FunctionCall.Arguments.
+type FunctionArguments string
+
+// UnmarshalJSON accepts either a JSON string or a JSON object.
+func (a *FunctionArguments) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = FunctionArguments(s)
+		return nil
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("function arguments must be a string or an object: %w", err)
+	}
+	*a = FunctionArguments(data)
+	return nil
+}
+
+// FunctionResultEntry carries the result of a function call back to the conversation.
+type FunctionResultEntry struct {
+	// Object is the object type, typically "entry".
+	Object string `json:"object,omitempty"`
+
+	// Type is the entry type. It is always "function.result" and is filled in automatically
+	// when the entry is encoded.
+	Type EntryType `json:"type,omitempty"`
+
+	// CreatedAt is the timestamp when the entry was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CompletedAt is the timestamp when the entry was completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// ID is the unique identifier of the entry.
+	ID string `json:"id,omitempty"`
+
+	// ToolCallID is the ID of the FunctionCallEntry this result answers.
+	ToolCallID string `json:"tool_call_id"`
+
+	// Result is the output of the function, usually as a string or JSON text.
+	Result string `json:"result"`
+}
+
+// EntryType returns EntryTypeFunctionResult.
+func (FunctionResultEntry) EntryType() EntryType { return EntryTypeFunctionResult }
+
+// MarshalJSON encodes the entry, setting the "type" field if it is empty.
+func (e FunctionResultEntry) MarshalJSON() ([]byte, error) {
+	type alias FunctionResultEntry
+	a := alias(e)
+	if a.Type == "" {
+		a.Type = EntryTypeFunctionResult
+	}
+	return json.Marshal(a)
+}
+
+// BuiltInConnector is the name of a tool executed on the Mistral servers.
+type BuiltInConnector string
+
+const (
+	// BuiltInConnectorWebSearch searches the web.
+	BuiltInConnectorWebSearch BuiltInConnector = "web_search"
+
+	// BuiltInConnectorWebSearchPremium searches the web and verified news sources.
+	BuiltInConnectorWebSearchPremium BuiltInConnector = "web_search_premium"
+
+	// BuiltInConnectorCodeInterpreter runs code in a sandbox.
+	BuiltInConnectorCodeInterpreter BuiltInConnector = "code_interpreter"
+
+	// BuiltInConnectorImageGeneration generates images.
+	BuiltInConnectorImageGeneration BuiltInConnector = "image_generation"
+
+	// BuiltInConnectorDocumentLibrary searches document libraries.
+	BuiltInConnectorDocumentLibrary BuiltInConnector = "document_library"
+)
+
+// ToolExecutionEntry records the execution of a built-in connector on the server.
+type ToolExecutionEntry struct {
+	// Object is the object type, typically "entry".
+	Object string `json:"object,omitempty"`
+
+	// Type is the entry type. It is always "tool.execution" and is filled in automatically
+	// when the entry is encoded.
+	Type EntryType `json:"type,omitempty"`
+
+	// CreatedAt is the timestamp when the entry was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CompletedAt is the timestamp when the entry was completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// ID is the unique identifier of the entry.
+	ID string `json:"id,omitempty"`
+
+	// Name is the built-in connector that was executed.
+	Name BuiltInConnector `json:"name"`
+
+	// Arguments is the JSON-encoded input passed to the connector.
+	Arguments string `json:"arguments"`
+
+	// Info contains connector-specific details about the execution.
+	Info map[string]interface{} `json:"info,omitempty"`
+}
+
+// EntryType returns EntryTypeToolExecution.
+func (ToolExecutionEntry) EntryType() EntryType { return EntryTypeToolExecution }
+
+// MarshalJSON encodes the entry, setting the "type" field if it is empty.
+func (e ToolExecutionEntry) MarshalJSON() ([]byte, error) {
+	type alias ToolExecutionEntry
+	a := alias(e)
+	if a.Type == "" {
+		a.Type = EntryTypeToolExecution
+	}
+	return json.Marshal(a)
+}
+
+// AgentHandoffEntry records a handoff from one agent to another during a conversation.
+type AgentHandoffEntry struct {
+	// Object is the object type, typically "entry".
+	Object string `json:"object,omitempty"`
+
+	// Type is the entry type. It is always "agent.handoff" and is filled in automatically
+	// when the entry is encoded.
+	Type EntryType `json:"type,omitempty"`
+
+	// CreatedAt is the timestamp when the entry was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CompletedAt is the timestamp when the entry was completed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// ID is the unique identifier of the entry.
+	ID string `json:"id,omitempty"`
+
+	// PreviousAgentID is the ID of the agent handing off control.
+	PreviousAgentID string `json:"previous_agent_id"`
+
+	// PreviousAgentName is the name of the agent handing off control.
+	PreviousAgentName string `json:"previous_agent_name"`
+
+	// NextAgentID is the ID of the agent receiving control.
+	NextAgentID string `json:"next_agent_id"`
+
+	// NextAgentName is the name of the agent receiving control.
+	NextAgentName string `json:"next_agent_name"`
+}
+
+// EntryType returns EntryTypeAgentHandoff.
+func (AgentHandoffEntry) EntryType() EntryType { return EntryTypeAgentHandoff }
+
+// MarshalJSON encodes the entry, setting the "type" field if it is empty.
+func (e AgentHandoffEntry) MarshalJSON() ([]byte, error) {
+	type alias AgentHandoffEntry
+	a := alias(e)
+	if a.Type == "" {
+		a.Type = EntryTypeAgentHandoff
+	}
+	return json.Marshal(a)
+}
+
+// HandoffExecution controls where agent handoffs are executed.
+type HandoffExecution string
+
+const (
+	// HandoffExecutionServer lets the server perform handoffs between agents (default).
+	HandoffExecutionServer HandoffExecution = "server"
+
+	// HandoffExecutionClient returns handoffs to the caller, who decides how to continue.
+	HandoffExecutionClient HandoffExecution = "client"
+)
+
+// Prediction provides expected output content so the model can speed up generation
+// when most of the response is known in advance.
+type Prediction struct {
+	// Type is the prediction type, always "content".
+	Type string `json:"type,omitempty"`
+
+	// Content is the predicted output.
+	Content string `json:"content"`
+}
+
+// CompletionArgs is the subset of chat completion parameters that can be applied to a
+// conversation. They are used to generate every assistant response in the conversation
+// unless overridden on a later request.
+type CompletionArgs struct {
+	// Stop contains sequences where the model will stop generating further tokens.
+	Stop []string `json:"stop,omitempty"`
+
+	// PresencePenalty is a number between -2.0 and 2.0 penalizing tokens that already appeared.
+	PresencePenalty *float64 `json:"presence_penalty,omitempty"`
+
+	// FrequencyPenalty is a number between -2.0 and 2.0 penalizing frequently repeated tokens.
+	FrequencyPenalty *float64 `json:"frequency_penalty,omitempty"`
+
+	// Temperature controls randomness in generation. Range: 0.0 to 1.0.
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// TopP is the nucleus sampling parameter. Range: 0.0 to 1.0.
+	TopP *float64 `json:"top_p,omitempty"`
+
+	// MaxTokens is the maximum number of tokens to generate per response.
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
+	// RandomSeed makes sampling attempt to be deterministic.
+	RandomSeed *int `json:"random_seed,omitempty"`
+
+	// Prediction is the expected output used to speed up generation.
+	Prediction *Prediction `json:"prediction,omitempty"`
+
+	// ResponseFormat specifies the format of the responses.
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+
+	// ToolChoice controls how the model uses the provided tools.
+	ToolChoice ToolChoice `json:"tool_choice,omitempty"`
+}
+
+// ConversationRequest represents a request to start a new conversation.
+// A conversation is started either with a base model (set Model) or with an agent
+// (set AgentID). The inputs are appended to the new conversation and a response is generated.
+type ConversationRequest struct {
+	// Inputs are the entries that start the conversation, usually a single MessageInputEntry
+	// with the user's prompt. This is required.
+	Inputs ConversationEntries `json:"inputs"`
+
+	// Stream indicates whether to stream the response as server-sent events.
+	// Set automatically by the streaming methods.
+	Stream bool `json:"stream,omitempty"`
+
+	// Store indicates whether the conversation is stored on the server. Defaults to true.
+	// Set to a pointer to false to run a one-off conversation.
+	Store *bool `json:"store,omitempty"`
+
+	// HandoffExecution controls whether agent handoffs are run by the server or the client.
+	HandoffExecution HandoffExecution `json:"handoff_execution,omitempty"`
+
+	// Instructions is an instruction prompt the model will follow during the conversation.
+	// Only used with Model.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Tools is a list of tools available to the model during the conversation.
+	// Only used with Model.
+	Tools []AgentTool `json:"tools,omitempty"`
+
+	// CompletionArgs are the generation parameters for the conversation. Only used with Model.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+
+	// Name is an optional name given to the conversation.
+	Name string `json:"name,omitempty"`
+
+	// Description is an optional description of what the conversation is about.
+	Description string `json:"description,omitempty"`
+
+	// AgentID is the ID of the agent to converse with. Mutually exclusive with Model.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// Model is the ID of the model to converse with. Mutually exclusive with AgentID.
+	Model string `json:"model,omitempty"`
+}
+
+// ConversationAppendRequest represents a request to append new entries to an existing
+// conversation and generate a response.
+type ConversationAppendRequest struct {
+	// Inputs are the entries to append, for example a new user message or function results.
+	// This is required.
+	Inputs ConversationEntries `json:"inputs"`
+
+	// Stream indicates whether to stream the response as server-sent events.
+	// Set automatically by the streaming methods.
+	Stream bool `json:"stream,omitempty"`
+
+	// Store indicates whether the results are stored on the server. Defaults to true.
+	Store *bool `json:"store,omitempty"`
+
+	// HandoffExecution controls whether agent handoffs are run by the server or the client.
+	HandoffExecution HandoffExecution `json:"handoff_execution,omitempty"`
+
+	// CompletionArgs overrides the conversation's generation parameters for this request.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+}
+
+// ConversationRestartRequest represents a request to restart a conversation from a given
+// entry. A new conversation is created containing the history up to FromEntryID followed
+// by the new inputs.
+type ConversationRestartRequest struct {
+	// Inputs are the entries to append after FromEntryID. This is required.
+	Inputs ConversationEntries `json:"inputs"`
+
+	// Stream indicates whether to stream the response as server-sent events.
+	// Set automatically by the streaming methods.
+	Stream bool `json:"stream,omitempty"`
+
+	// Store indicates whether the results are stored on the server. Defaults to true.
+	Store *bool `json:"store,omitempty"`
+
+	// HandoffExecution controls whether agent handoffs are run by the server or the client.
+	HandoffExecution HandoffExecution `json:"handoff_execution,omitempty"`
+
+	// CompletionArgs overrides the conversation's generation parameters for this request.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+
+	// FromEntryID is the ID of the entry from which the conversation is restarted.
+	// This is required.
+	FromEntryID string `json:"from_entry_id"`
+}
+
+// ConversationUsage represents token usage statistics for a conversation request,
+// including tokens consumed by built-in connectors.
+type ConversationUsage struct {
+	// PromptTokens is the number of tokens in the input.
+	PromptTokens int `json:"prompt_tokens"`
+
+	// CompletionTokens is the number of tokens generated.
+	CompletionTokens int `json:"completion_tokens"`
+
+	// TotalTokens is the total number of tokens used.
+	TotalTokens int `json:"total_tokens"`
+
+	// ConnectorTokens is the number of tokens consumed by built-in connectors, if any.
+	ConnectorTokens *int `json:"connector_tokens,omitempty"`
+
+	// Connectors maps each built-in connector to the number of times it was called.
+	Connectors map[BuiltInConnector]int `json:"connectors,omitempty"`
+}
+
+// ConversationResponse is returned after starting, appending to, or restarting a conversation.
+// It contains the entries produced by the model, tools and agents for this request.
+type ConversationResponse struct {
+	// Object is the object type, typically "conversation.response".
+	Object string `json:"object"`
+
+	// ConversationID is the ID of the conversation. Use it to append to the conversation later.
+	ConversationID string `json:"conversation_id"`
+
+	// Outputs are the new entries: *MessageOutputEntry, *ToolExecutionEntry,
+	// *FunctionCallEntry or *AgentHandoffEntry.
+	Outputs ConversationEntries `json:"outputs"`
+
+	// Usage contains token usage statistics for this request.
+	Usage ConversationUsage `json:"usage"`
+}
+
+// ConversationHistory contains every entry of a conversation in the order they were appended.
+type ConversationHistory struct {
+	// Object is the object type, typically "conversation.history".
+	Object string `json:"object"`
+
+	// ConversationID is the ID of the conversation.
+	ConversationID string `json:"conversation_id"`
+
+	// Entries are all the entries of the conversation.
+	Entries ConversationEntries `json:"entries"`
+}
+
+// ConversationMessages contains only the message entries of a conversation.
+type ConversationMessages struct {
+	// Object is the object type, typically "conversation.messages".
+	Object string `json:"object"`
+
+	// ConversationID is the ID of the conversation.
+	ConversationID string `json:"conversation_id"`
+
+	// Messages are the *MessageInputEntry and *MessageOutputEntry entries of the conversation.
+	Messages ConversationEntries `json:"messages"`
+}
+
+// ListConversationsParams represents optional parameters for paginating conversation lists.
+// All fields are optional; omit them or use zero values to use defaults.
+type ListConversationsParams struct {
+	// Page is the page number to retrieve (0-indexed). If 0, returns the first page.
+	Page int
+
+	// PageSize is the number of conversations to return per page. If 0, uses the API's
+	// default page size.
+	PageSize int
+}
+
+// ConversationEventType identifies the kind of a server-sent event emitted while streaming
+// a conversation response.
+type ConversationEventType string
+
+const (
+	// EventTypeResponseStarted is sent once when the response starts.
+	EventTypeResponseStarted ConversationEventType = "conversation.response.started"
+
+	// EventTypeResponseDone is sent once when the response is complete, with usage statistics.
+	EventTypeResponseDone ConversationEventType = "conversation.response.done"
+
+	// EventTypeResponseError is sent when the response fails.
+	EventTypeResponseError ConversationEventType = "conversation.response.error"
+
+	// EventTypeMessageOutputDelta carries a fragment of a message generated by the model.
+	EventTypeMessageOutputDelta ConversationEventType = "message.output.delta"
+
+	// EventTypeToolExecutionStarted is sent when a built-in connector starts running.
+	EventTypeToolExecutionStarted ConversationEventType = "tool.execution.started"
+
+	// EventTypeToolExecutionDelta carries a fragment of a built-in connector's arguments.
+	EventTypeToolExecutionDelta ConversationEventType = "tool.execution.delta"
+
+	// EventTypeToolExecutionDone is sent when a built-in connector has finished running.
+	EventTypeToolExecutionDone ConversationEventType = "tool.execution.done"
+
+	// EventTypeAgentHandoffStarted is sent when an agent starts handing off control.
+	EventTypeAgentHandoffStarted ConversationEventType = "agent.handoff.started"
+
+	// EventTypeAgentHandoffDone is sent when the handoff to the next agent is complete.
+	EventTypeAgentHandoffDone ConversationEventType = "agent.handoff.done"
+
+	// EventTypeFunctionCallDelta carries a fragment of a function call requested by the model.
+	EventTypeFunctionCallDelta ConversationEventType = "function.call.delta"
+)
+
+// ConversationEvent is implemented by every event that can be received while streaming a
+// conversation: *ResponseStartedEvent, *ResponseDoneEvent, *ResponseErrorEvent,
+// *MessageOutputEvent, *ToolExecutionStartedEvent, *ToolExecutionDeltaEvent,
+// *ToolExecutionDoneEvent, *AgentHandoffStartedEvent, *AgentHandoffDoneEvent and
+// *FunctionCallEvent. Use a type switch on the pointer types to handle each kind.
+type ConversationEvent interface {
+	// EventType returns the name of the event.
+	EventType() ConversationEventType
+}
+
+// decodeConversationEvent decodes the data of a server-sent event into the concrete event type
+// named by eventType. It returns a nil event and no error for event types it does not know, so
+// that new server-side events do not break existing streams.
+func decodeConversationEvent(eventType ConversationEventType, data []byte) (ConversationEvent, error) {
+	if eventType == "" {
+		var head struct {
+			Type ConversationEventType `json:"type"`
+		}
+		if err := json.Unmarshal(data, &head); err != nil {
+			return nil, err
+		}
+		eventType = head.Type
+	}
+
+	var event ConversationEvent
+	switch eventType {
+	case EventTypeResponseStarted:
+		event = &ResponseStartedEvent{}
+	case EventTypeResponseDone:
+		event = &ResponseDoneEvent{}
+	case EventTypeResponseError:
+		event = &ResponseErrorEvent{}
+	case EventTypeMessageOutputDelta:
+		event = &MessageOutputEvent{}
+	case EventTypeToolExecutionStarted:
+		event = &ToolExecutionStartedEvent{}
+	case EventTypeToolExecutionDelta:
+		event = &ToolExecutionDeltaEvent{}
+	case EventTypeToolExecutionDone:
+		event = &ToolExecutionDoneEvent{}
+	case EventTypeAgentHandoffStarted:
+		event = &AgentHandoffStartedEvent{}
+	case EventTypeAgentHandoffDone:
+		event = &AgentHandoffDoneEvent{}
+	case EventTypeFunctionCallDelta:
+		event = &FunctionCallEvent{}
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ResponseStartedEvent is sent once when a conversation response starts.
+type ResponseStartedEvent struct {
+	// Type is the event type, always "conversation.response.started".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// ConversationID is the ID of the conversation being responded to.
+	ConversationID string `json:"conversation_id"`
+}
+
+// EventType returns EventTypeResponseStarted.
+func (ResponseStartedEvent) EventType() ConversationEventType { return EventTypeResponseStarted }
+
+// ResponseDoneEvent is sent once when a conversation response is complete.
+type ResponseDoneEvent struct {
+	// Type is the event type, always "conversation.response.done".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Usage contains token usage statistics for the whole response.
+	Usage ConversationUsage `json:"usage"`
+}
+
+// EventType returns EventTypeResponseDone.
+func (ResponseDoneEvent) EventType() ConversationEventType { return EventTypeResponseDone }
+
+// ResponseErrorEvent is sent when a conversation response fails on the server.
+type ResponseErrorEvent struct {
+	// Type is the event type, always "conversation.response.error".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Message is a human-readable description of the error.
+	Message string `json:"message"`
+
+	// Code is the error code.
+	Code int `json:"code"`
+}
+
+// EventType returns EventTypeResponseError.
+func (ResponseErrorEvent) EventType() ConversationEventType { return EventTypeResponseError }
+
+// MessageOutputEvent carries a fragment of a message generated by the model. Concatenate the
+// content of the events sharing the same ID to rebuild the full message.
+type MessageOutputEvent struct {
+	// Type is the event type, always "message.output.delta".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry this fragment belongs to.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the message entry being generated.
+	ID string `json:"id"`
+
+	// ContentIndex is the index of the content chunk this fragment belongs to.
+	ContentIndex int `json:"content_index"`
+
+	// Model is the model generating the message, if any.
+	Model string `json:"model,omitempty"`
+
+	// AgentID is the agent generating the message, if any.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// Role is the author of the message, always "assistant".
+	Role Role `json:"role,omitempty"`
+
+	// Content is the fragment of content. Usually plain text, but may be chunks (for
+	// example tool references).
+	Content Content `json:"content"`
+}
+
+// EventType returns EventTypeMessageOutputDelta.
+func (MessageOutputEvent) EventType() ConversationEventType { return EventTypeMessageOutputDelta }
+
+// ToolExecutionStartedEvent is sent when a built-in connector starts running.
+type ToolExecutionStartedEvent struct {
+	// Type is the event type, always "tool.execution.started".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry for this execution.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the tool execution entry.
+	ID string `json:"id"`
+
+	// Name is the built-in connector being executed.
+	Name BuiltInConnector `json:"name"`
+
+	// Arguments is the JSON-encoded input passed to the connector.
+	Arguments string `json:"arguments"`
+}
+
+// EventType returns EventTypeToolExecutionStarted.
+func (ToolExecutionStartedEvent) EventType() ConversationEventType {
+	return EventTypeToolExecutionStarted
+}
+
+// ToolExecutionDeltaEvent carries a fragment of the arguments of a running built-in connector.
+type ToolExecutionDeltaEvent struct {
+	// Type is the event type, always "tool.execution.delta".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry for this execution.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the tool execution entry.
+	ID string `json:"id"`
+
+	// Name is the built-in connector being executed.
+	Name BuiltInConnector `json:"name"`
+
+	// Arguments is a fragment of the JSON-encoded input passed to the connector.
+	Arguments string `json:"arguments"`
+}
+
+// EventType returns EventTypeToolExecutionDelta.
+func (ToolExecutionDeltaEvent) EventType() ConversationEventType { return EventTypeToolExecutionDelta }
+
+// ToolExecutionDoneEvent is sent when a built-in connector has finished running.
+type ToolExecutionDoneEvent struct {
+	// Type is the event type, always "tool.execution.done".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry for this execution.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the tool execution entry.
+	ID string `json:"id"`
+
+	// Name is the built-in connector that was executed.
+	Name BuiltInConnector `json:"name"`
+
+	// Info contains connector-specific details about the execution.
+	Info map[string]interface{} `json:"info,omitempty"`
+}
+
+// EventType returns EventTypeToolExecutionDone.
+func (ToolExecutionDoneEvent) EventType() ConversationEventType { return EventTypeToolExecutionDone }
+
+// AgentHandoffStartedEvent is sent when an agent starts handing off control to another agent.
+type AgentHandoffStartedEvent struct {
+	// Type is the event type, always "agent.handoff.started".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry for this handoff.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the handoff entry.
+	ID string `json:"id"`
+
+	// PreviousAgentID is the ID of the agent handing off control.
+	PreviousAgentID string `json:"previous_agent_id"`
+
+	// PreviousAgentName is the name of the agent handing off control.
+	PreviousAgentName string `json:"previous_agent_name"`
+}
+
+// EventType returns EventTypeAgentHandoffStarted.
+func (AgentHandoffStartedEvent) EventType() ConversationEventType {
+	return EventTypeAgentHandoffStarted
+}
+
+// AgentHandoffDoneEvent is sent when the handoff to the next agent is complete.
+type AgentHandoffDoneEvent struct {
+	// Type is the event type, always "agent.handoff.done".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry for this handoff.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the handoff entry.
+	ID string `json:"id"`
+
+	// NextAgentID is the ID of the agent receiving control.
+	NextAgentID string `json:"next_agent_id"`
+
+	// NextAgentName is the name of the agent receiving control.
+	NextAgentName string `json:"next_agent_name"`
+}
+
+// EventType returns EventTypeAgentHandoffDone.
+func (AgentHandoffDoneEvent) EventType() ConversationEventType { return EventTypeAgentHandoffDone }
+
+// FunctionCallEvent carries a fragment of a function call requested by the model. Concatenate
+// the arguments of the events sharing the same ToolCallID to rebuild the full call.
+type FunctionCallEvent struct {
+	// Type is the event type, always "function.call.delta".
+	Type ConversationEventType `json:"type"`
+
+	// CreatedAt is the timestamp of the event.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// OutputIndex is the index of the output entry for this call.
+	OutputIndex int `json:"output_index"`
+
+	// ID is the ID of the function call entry.
+	ID string `json:"id"`
+
+	// Name is the name of the function to call.
+	Name string `json:"name"`
+
+	// ToolCallID is the identifier of the call, used to match it with its result.
+	ToolCallID string `json:"tool_call_id"`
+
+	// Arguments is a fragment of the JSON-encoded arguments for the function.
+	Arguments string `json:"arguments"`
+}
+
+// EventType returns EventTypeFunctionCallDelta.
+func (FunctionCallEvent) EventType() ConversationEventType { return EventTypeFunctionCallDelta }
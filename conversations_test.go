@@ -0,0 +1,78 @@
+package mistral
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversationEntriesRoundTrip(t *testing.T) {
+	entries := ConversationEntries{
+		&MessageInputEntry{Role: RoleUser, Content: TextContent("Hello")},
+		MessageOutputEntry{Content: TextContent("Hi")},
+		&FunctionCallEntry{ToolCallID: "call-1", Name: "lookup", Arguments: `{"q":"x"}`},
+		&FunctionResultEntry{ToolCallID: "call-1", Result: "ok"},
+		&ToolExecutionEntry{Name: BuiltInConnectorCodeInterpreter, Arguments: "{}"},
+		&AgentHandoffEntry{PreviousAgentID: "ag-1", NextAgentID: "ag-2"},
+	}
+
+	data, err := json.Marshal(entries)
+	require.NoError(t, err)
+
+	var decoded ConversationEntries
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Len(t, decoded, len(entries))
+
+	for i, entry := range entries {
+		assert.Equal(t, entry.EntryType(), decoded[i].EntryType())
+	}
+	assert.Equal(t, FunctionArguments(`{"q":"x"}`), decoded[2].(*FunctionCallEntry).Arguments)
+}
+
+func TestConversationEntriesUnknownType(t *testing.T) {
+	var entries ConversationEntries
+	err := json.Unmarshal([]byte(`[{"type": "something.new"}]`), &entries)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "something.new")
+}
+
+func TestMessageOutputEntryChunkContent(t *testing.T) {
+	var entries ConversationEntries
+	require.NoError(t, json.Unmarshal([]byte(`[{"type": "message.output", "role": "assistant", "content": [
+		{"type": "text", "text": "Paris is sunny."},
+		{"type": "tool_reference", "tool": "web_search", "title": "Weather in Paris", "url": "https://example.com"}
+	]}]`), &entries))
+
+	require.Len(t, entries, 1)
+	msg, ok := entries[0].(*MessageOutputEntry)
+	require.True(t, ok, "entry should be a *MessageOutputEntry")
+	assert.Equal(t, "Paris is sunny.", msg.Content.Text())
+	chunks := msg.Content.Chunks()
+	require.Len(t, chunks, 2)
+	assert.Equal(t, &ToolReferenceChunk{Type: ChunkTypeToolReference, Tool: BuiltInConnectorWebSearch, Title: "Weather in Paris", URL: "https://example.com"}, chunks[1])
+}
+
+func TestFunctionArgumentsUnmarshal(t *testing.T) {
+	var fromString FunctionArguments
+	require.NoError(t, json.Unmarshal([]byte(`"{\"a\":1}"`), &fromString))
+	assert.Equal(t, FunctionArguments(`{"a":1}`), fromString)
+
+	var fromObject FunctionArguments
+	require.NoError(t, json.Unmarshal([]byte(`{"a":1}`), &fromObject))
+	assert.Equal(t, FunctionArguments(`{"a":1}`), fromObject)
+
+	var invalid FunctionArguments
+	assert.Error(t, json.Unmarshal([]byte(`[1, 2]`), &invalid))
+}
+
+func TestDecodeConversationEventFallsBackToType(t *testing.T) {
+	event, err := decodeConversationEvent("", []byte(`{"type": "agent.handoff.done", "id": "h-1", "next_agent_id": "ag-2", "next_agent_name": "billing"}`))
+
+	require.NoError(t, err)
+	handoff, ok := event.(*AgentHandoffDoneEvent)
+	require.True(t, ok, "event should be an *AgentHandoffDoneEvent")
+	assert.Equal(t, "billing", handoff.NextAgentName)
+}
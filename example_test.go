@@ -17,7 +17,7 @@ func ExampleClient_CreateChatCompletion() {
 		Messages: []mistral.ChatMessage{
 			{
 				Role:    mistral.RoleUser,
-				Content: "What is the capital of France?",
+				Content: mistral.TextContent("What is the capital of France?"),
 			},
 		},
 	})
@@ -25,7 +25,7 @@ func ExampleClient_CreateChatCompletion() {
 		log.Fatal(err)
 	}
 
-	fmt.Println(resp.Choices[0].Message.Content)
+	fmt.Println(resp.Choices[0].Message.Content.Text())
 }
 
 func ExampleClient_CreateChatCompletionStream() {
@@ -37,7 +37,7 @@ func ExampleClient_CreateChatCompletionStream() {
 		Messages: []mistral.ChatMessage{
 			{
 				Role:    mistral.RoleUser,
-				Content: "Tell me a short story",
+				Content: mistral.TextContent("Tell me a short story"),
 			},
 		},
 	})
@@ -49,7 +49,7 @@ func ExampleClient_CreateChatCompletionStream() {
 				return
 			}
 			if len(chunk.Choices) > 0 && chunk.Choices[0].Delta != nil {
-				fmt.Print(chunk.Choices[0].Delta.Content)
+				fmt.Print(chunk.Choices[0].Delta.Content.Text())
 			}
 		case err := <-errChan:
 			if err != nil {
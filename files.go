@@ -17,6 +17,44 @@ const (
 	// Batch files allow you to process multiple API requests asynchronously
 	// at a reduced cost compared to real-time API calls.
 	FilePurposeBatch FilePurpose = "batch"
+
+	// FilePurposeOCR indicates the file is a document to be processed with the OCR API.
+	FilePurposeOCR FilePurpose = "ocr"
+)
+
+// FileSampleType describes the content of a file.
+type FileSampleType string
+
+const (
+	// FileSampleTypePretrain indicates the file contains raw text for pretraining.
+	FileSampleTypePretrain FileSampleType = "pretrain"
+
+	// FileSampleTypeInstruct indicates the file contains instruction examples for fine-tuning.
+	FileSampleTypeInstruct FileSampleType = "instruct"
+
+	// FileSampleTypeBatchRequest indicates the file contains batch requests.
+	FileSampleTypeBatchRequest FileSampleType = "batch_request"
+
+	// FileSampleTypeBatchResult indicates the file contains the results of a batch job.
+	FileSampleTypeBatchResult FileSampleType = "batch_result"
+
+	// FileSampleTypeBatchError indicates the file contains the errors of a batch job.
+	FileSampleTypeBatchError FileSampleType = "batch_error"
+)
+
+// FileSource indicates where a file comes from.
+type FileSource string
+
+const (
+	// FileSourceUpload indicates the file was uploaded with UploadFile.
+	FileSourceUpload FileSource = "upload"
+
+	// FileSourceRepository indicates the file was imported from a repository.
+	FileSourceRepository FileSource = "repository"
+
+	// FileSourceMistral indicates the file was generated by Mistral, such as the output
+	// and error files of a batch job.
+	FileSourceMistral FileSource = "mistral"
 )
 
 // UploadFileRequest represents a request to upload a file to the Mistral API.
@@ -0,0 +1,40 @@
+package mistral
+
+// FIMCompletionRequest represents a request to the fill-in-the-middle (FIM) completions API.
+// FIM is designed for code completion: given the code before the cursor (Prompt) and
+// optionally the code after it (Suffix), the model generates the code in between.
+type FIMCompletionRequest struct {
+	// Model is the ID of the model to use (e.g., "codestral-latest"). This is required.
+	Model string `json:"model"`
+
+	// Prompt is the text or code to complete, i.e. everything before the insertion point.
+	// This is required.
+	Prompt string `json:"prompt"`
+
+	// Suffix is optional text or code following the insertion point. When set, the model
+	// fills in what goes between Prompt and Suffix; otherwise it simply continues Prompt.
+	Suffix string `json:"suffix,omitempty"`
+
+	// Temperature controls randomness in generation. Range: 0.0 to 1.5. Values between
+	// 0.0 and 0.7 are recommended for code.
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// TopP is the nucleus sampling parameter. Range: 0.0 to 1.0.
+	TopP *float64 `json:"top_p,omitempty"`
+
+	// MaxTokens is the maximum number of tokens to generate in the completion.
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
+	// MinTokens is the minimum number of tokens to generate in the completion.
+	MinTokens *int `json:"min_tokens,omitempty"`
+
+	// Stream indicates whether to stream partial completions as server-sent events.
+	// If true, use CreateFIMCompletionStream instead of CreateFIMCompletion.
+	Stream bool `json:"stream,omitempty"`
+
+	// Stop contains sequences where the API will stop generating further tokens.
+	Stop []string `json:"stop,omitempty"`
+
+	// RandomSeed, if specified, makes the system attempt to sample deterministically.
+	RandomSeed *int `json:"random_seed,omitempty"`
+}
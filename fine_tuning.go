@@ -0,0 +1,455 @@
+package mistral
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FineTuningJobType is the kind of model produced by a fine-tuning job.
+type FineTuningJobType string
+
+const (
+	// FineTuningJobTypeCompletion fine-tunes a model for chat or text completion.
+	FineTuningJobTypeCompletion FineTuningJobType = "completion"
+
+	// FineTuningJobTypeClassifier fine-tunes a model to classify text into custom labels.
+	FineTuningJobTypeClassifier FineTuningJobType = "classifier"
+)
+
+// FineTuningJobStatus is the state of a fine-tuning job.
+type FineTuningJobStatus string
+
+const (
+	// FineTuningJobStatusQueued indicates the job is waiting to be processed.
+	FineTuningJobStatusQueued FineTuningJobStatus = "QUEUED"
+
+	// FineTuningJobStatusStarted indicates the job has been picked up.
+	FineTuningJobStatusStarted FineTuningJobStatus = "STARTED"
+
+	// FineTuningJobStatusValidating indicates the training files are being validated.
+	FineTuningJobStatusValidating FineTuningJobStatus = "VALIDATING"
+
+	// FineTuningJobStatusValidated indicates validation succeeded. If the job was created
+	// with AutoStart set to false, call StartFineTuningJob to begin training.
+	FineTuningJobStatusValidated FineTuningJobStatus = "VALIDATED"
+
+	// FineTuningJobStatusRunning indicates the model is being trained.
+	FineTuningJobStatusRunning FineTuningJobStatus = "RUNNING"
+
+	// FineTuningJobStatusFailedValidation indicates the training files were rejected.
+	FineTuningJobStatusFailedValidation FineTuningJobStatus = "FAILED_VALIDATION"
+
+	// FineTuningJobStatusFailed indicates training failed.
+	FineTuningJobStatusFailed FineTuningJobStatus = "FAILED"
+
+	// FineTuningJobStatusSuccess indicates training completed and the model is available.
+	FineTuningJobStatusSuccess FineTuningJobStatus = "SUCCESS"
+
+	// FineTuningJobStatusCancelled indicates the job was cancelled.
+	FineTuningJobStatusCancelled FineTuningJobStatus = "CANCELLED"
+
+	// FineTuningJobStatusCancellationRequested indicates a cancellation is in progress.
+	FineTuningJobStatusCancellationRequested FineTuningJobStatus = "CANCELLATION_REQUESTED"
+)
+
+// IsTerminal reports whether a job in this state will not change anymore.
+func (s FineTuningJobStatus) IsTerminal() bool {
+	switch s {
+	case FineTuningJobStatusSuccess, FineTuningJobStatusFailed, FineTuningJobStatusFailedValidation, FineTuningJobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// ClassifierLossFunction is the loss function used to train a classifier target.
+type ClassifierLossFunction string
+
+const (
+	// ClassifierLossFunctionSingleClass is used when each sample has exactly one label.
+	ClassifierLossFunctionSingleClass ClassifierLossFunction = "single_class"
+
+	// ClassifierLossFunctionMultiClass is used when each sample can have several labels.
+	ClassifierLossFunctionMultiClass ClassifierLossFunction = "multi_class"
+)
+
+// TrainingFile references an uploaded file (purpose FilePurposeFineTune) used as training data.
+type TrainingFile struct {
+	// FileID is the ID of the uploaded training file.
+	FileID string `json:"file_id"`
+
+	// Weight is the relative weight of this file in the training mix. Defaults to 1.
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// TrainingParameters are the hyperparameters of a fine-tuning job. All fields are optional;
+// unset fields use the API defaults.
+type TrainingParameters struct {
+	// TrainingSteps is the number of training steps to perform.
+	TrainingSteps *int `json:"training_steps,omitempty"`
+
+	// LearningRate is the learning rate. Defaults to 0.0001.
+	LearningRate *float64 `json:"learning_rate,omitempty"`
+
+	// WeightDecay is the weight decay. Defaults to 0.1.
+	WeightDecay *float64 `json:"weight_decay,omitempty"`
+
+	// WarmupFraction is the fraction of steps used for learning rate warmup. Defaults to 0.05.
+	WarmupFraction *float64 `json:"warmup_fraction,omitempty"`
+
+	// Epochs is the number of passes over the training data.
+	Epochs *float64 `json:"epochs,omitempty"`
+
+	// SeqLen is the maximum sequence length used during training.
+	SeqLen *int `json:"seq_len,omitempty"`
+
+	// FIMRatio is the ratio of fill-in-the-middle samples. Only used by completion jobs
+	// on code models. Defaults to 0.9.
+	FIMRatio *float64 `json:"fim_ratio,omitempty"`
+}
+
+// WandbIntegration reports training metrics of a job to Weights & Biases.
+type WandbIntegration struct {
+	// Type is the integration type, always "wandb".
+	Type string `json:"type,omitempty"`
+
+	// Project is the Weights & Biases project to report to.
+	Project string `json:"project"`
+
+	// Name is an optional display name for the integration.
+	Name string `json:"name,omitempty"`
+
+	// APIKey is your Weights & Biases API key. Only sent when creating a job; never returned.
+	APIKey string `json:"api_key,omitempty"`
+
+	// RunName is an optional name for the Weights & Biases run.
+	RunName string `json:"run_name,omitempty"`
+
+	// URL is the link to the Weights & Biases run. Only set in responses.
+	URL string `json:"url,omitempty"`
+}
+
+// GithubRepository is a GitHub repository used as training data.
+type GithubRepository struct {
+	// Type is the repository type, always "github".
+	Type string `json:"type,omitempty"`
+
+	// Name is the name of the repository.
+	Name string `json:"name"`
+
+	// Owner is the user or organization owning the repository.
+	Owner string `json:"owner"`
+
+	// Ref is an optional branch, tag or commit to use.
+	Ref string `json:"ref,omitempty"`
+
+	// Weight is the relative weight of this repository in the training mix. Defaults to 1.
+	Weight float64 `json:"weight,omitempty"`
+
+	// Token is a GitHub token with read access to the repository. Only sent when creating
+	// a job; never returned.
+	Token string `json:"token,omitempty"`
+
+	// CommitID is the commit that was used for training. Only set in responses.
+	CommitID string `json:"commit_id,omitempty"`
+}
+
+// ClassifierTarget is one of the outputs a classifier model is trained to predict.
+type ClassifierTarget struct {
+	// Name is the name of the target.
+	Name string `json:"name"`
+
+	// Labels are the possible labels of the target.
+	Labels []string `json:"labels"`
+
+	// Weight is the relative weight of this target in the loss. Defaults to 1.
+	Weight float64 `json:"weight,omitempty"`
+
+	// LossFunction is the loss function used for this target.
+	LossFunction ClassifierLossFunction `json:"loss_function,omitempty"`
+}
+
+// FineTuningJobRequest represents a request to create a fine-tuning job.
+type FineTuningJobRequest struct {
+	// Model is the base model to fine-tune (e.g., "open-mistral-nemo"). This is required.
+	Model string `json:"model"`
+
+	// TrainingFiles are the uploaded files used for training.
+	TrainingFiles []TrainingFile `json:"training_files,omitempty"`
+
+	// ValidationFiles are the IDs of uploaded files used to compute validation metrics.
+	ValidationFiles []string `json:"validation_files,omitempty"`
+
+	// Suffix is a string appended to the fine-tuned model's name to identify it.
+	Suffix string `json:"suffix,omitempty"`
+
+	// Integrations are the external services training metrics are reported to.
+	Integrations []WandbIntegration `json:"integrations,omitempty"`
+
+	// AutoStart indicates whether training starts automatically after validation. Set to a
+	// pointer to false to review the job (and its cost estimate) and then call
+	// StartFineTuningJob.
+	AutoStart *bool `json:"auto_start,omitempty"`
+
+	// InvalidSampleSkipPercentage is the percentage of invalid training samples that are
+	// tolerated (and skipped) before the job fails validation.
+	InvalidSampleSkipPercentage *float64 `json:"invalid_sample_skip_percentage,omitempty"`
+
+	// JobType is the kind of model to produce. Defaults to FineTuningJobTypeCompletion.
+	JobType FineTuningJobType `json:"job_type,omitempty"`
+
+	// Hyperparameters are the training hyperparameters. This is required, but may be empty.
+	Hyperparameters TrainingParameters `json:"hyperparameters"`
+
+	// Repositories are GitHub repositories used as additional training data.
+	Repositories []GithubRepository `json:"repositories,omitempty"`
+
+	// ClassifierTargets are the targets to train. Only used with FineTuningJobTypeClassifier.
+	ClassifierTargets []ClassifierTarget `json:"classifier_targets,omitempty"`
+}
+
+// FineTuningJobMetadata contains cost and duration estimates for a fine-tuning job.
+type FineTuningJobMetadata struct {
+	// ExpectedDurationSeconds is the estimated training duration.
+	ExpectedDurationSeconds *int `json:"expected_duration_seconds,omitempty"`
+
+	// Cost is the estimated cost of the job.
+	Cost *float64 `json:"cost,omitempty"`
+
+	// CostCurrency is the currency of Cost.
+	CostCurrency string `json:"cost_currency,omitempty"`
+
+	// TrainTokensPerStep is the number of tokens processed per training step.
+	TrainTokensPerStep *int `json:"train_tokens_per_step,omitempty"`
+
+	// TrainTokens is the total number of tokens processed during training.
+	TrainTokens *int `json:"train_tokens,omitempty"`
+
+	// DataTokens is the number of tokens in the training data.
+	DataTokens *int `json:"data_tokens,omitempty"`
+
+	// EstimatedStartTime is a Unix timestamp of when training is expected to start.
+	EstimatedStartTime *int64 `json:"estimated_start_time,omitempty"`
+}
+
+// FineTuningJobEvent is an event in the lifecycle of a fine-tuning job, such as a status change.
+type FineTuningJobEvent struct {
+	// Name is the name of the event (e.g., "status-updated").
+	Name string `json:"name"`
+
+	// Data contains event-specific details.
+	Data map[string]interface{} `json:"data,omitempty"`
+
+	// CreatedAt is a Unix timestamp of when the event occurred.
+	CreatedAt int64 `json:"created_at"`
+}
+
+// FineTuningMetrics are the training metrics recorded at a checkpoint.
+type FineTuningMetrics struct {
+	// TrainLoss is the loss on the training data.
+	TrainLoss *float64 `json:"train_loss,omitempty"`
+
+	// ValidLoss is the loss on the validation data.
+	ValidLoss *float64 `json:"valid_loss,omitempty"`
+
+	// ValidMeanTokenAccuracy is the mean token accuracy on the validation data.
+	ValidMeanTokenAccuracy *float64 `json:"valid_mean_token_accuracy,omitempty"`
+}
+
+// FineTuningCheckpoint is a snapshot of the model taken during training.
+type FineTuningCheckpoint struct {
+	// Metrics are the training metrics at this checkpoint.
+	Metrics FineTuningMetrics `json:"metrics"`
+
+	// StepNumber is the training step at which the checkpoint was taken.
+	StepNumber int `json:"step_number"`
+
+	// CreatedAt is a Unix timestamp of when the checkpoint was created.
+	CreatedAt int64 `json:"created_at"`
+}
+
+// FineTuningJob is implemented by *CompletionJob and *ClassifierJob, the two kinds of
+// fine-tuning jobs. Use a type switch to access the fields specific to each kind, or
+// Details to access the fields they share.
+type FineTuningJob interface {
+	// Details returns the fields shared by all kinds of fine-tuning jobs.
+	Details() *FineTuningJobDetails
+}
+
+// FineTuningJobDetails contains the fields shared by all kinds of fine-tuning jobs.
+// It is embedded in CompletionJob and ClassifierJob.
+type FineTuningJobDetails struct {
+	// ID is the unique identifier of the job.
+	ID string `json:"id"`
+
+	// Object is the object type, typically "job".
+	Object string `json:"object"`
+
+	// JobType is the kind of job.
+	JobType FineTuningJobType `json:"job_type"`
+
+	// AutoStart indicates whether training starts automatically after validation.
+	AutoStart bool `json:"auto_start"`
+
+	// Model is the base model being fine-tuned.
+	Model string `json:"model"`
+
+	// Status is the current state of the job.
+	Status FineTuningJobStatus `json:"status"`
+
+	// CreatedAt is a Unix timestamp of when the job was created.
+	CreatedAt int64 `json:"created_at"`
+
+	// ModifiedAt is a Unix timestamp of when the job was last modified.
+	ModifiedAt int64 `json:"modified_at"`
+
+	// TrainingFiles are the IDs of the training files.
+	TrainingFiles []string `json:"training_files"`
+
+	// ValidationFiles are the IDs of the validation files.
+	ValidationFiles []string `json:"validation_files,omitempty"`
+
+	// FineTunedModel is the ID of the resulting model, set once training succeeds.
+	FineTunedModel string `json:"fine_tuned_model,omitempty"`
+
+	// Suffix is the string appended to the fine-tuned model's name.
+	Suffix string `json:"suffix,omitempty"`
+
+	// Integrations are the external services training metrics are reported to.
+	Integrations []WandbIntegration `json:"integrations,omitempty"`
+
+	// TrainedTokens is the number of tokens processed during training.
+	TrainedTokens *int `json:"trained_tokens,omitempty"`
+
+	// Metadata contains cost and duration estimates.
+	Metadata *FineTuningJobMetadata `json:"metadata,omitempty"`
+
+	// Hyperparameters are the training hyperparameters.
+	Hyperparameters TrainingParameters `json:"hyperparameters"`
+
+	// Events is the lifecycle history of the job. Only returned by GetFineTuningJob,
+	// CancelFineTuningJob and StartFineTuningJob.
+	Events []FineTuningJobEvent `json:"events,omitempty"`
+
+	// Checkpoints are the snapshots taken during training, with their metrics. Only returned
+	// by GetFineTuningJob, CancelFineTuningJob and StartFineTuningJob.
+	Checkpoints []FineTuningCheckpoint `json:"checkpoints,omitempty"`
+}
+
+// Details returns d itself. It allows the job types embedding FineTuningJobDetails to
+// satisfy the FineTuningJob interface.
+func (d *FineTuningJobDetails) Details() *FineTuningJobDetails { return d }
+
+// CompletionJob is a fine-tuning job producing a completion model.
+type CompletionJob struct {
+	FineTuningJobDetails
+
+	// Repositories are the GitHub repositories used as training data.
+	Repositories []GithubRepository `json:"repositories,omitempty"`
+}
+
+// ClassifierJob is a fine-tuning job producing a classifier model.
+type ClassifierJob struct {
+	FineTuningJobDetails
+
+	// ClassifierTargets are the targets the classifier is trained to predict.
+	ClassifierTargets []ClassifierTarget `json:"classifier_targets,omitempty"`
+}
+
+// unmarshalFineTuningJob decodes a job into *CompletionJob or *ClassifierJob depending on its
+// "job_type" field. Jobs without a job type are decoded as completion jobs.
+func unmarshalFineTuningJob(data []byte) (FineTuningJob, error) {
+	var head struct {
+		JobType FineTuningJobType `json:"job_type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+
+	var job FineTuningJob
+	switch head.JobType {
+	case FineTuningJobTypeCompletion, "":
+		job = &CompletionJob{}
+	case FineTuningJobTypeClassifier:
+		job = &ClassifierJob{}
+	default:
+		return nil, fmt.Errorf("unknown fine-tuning job type %q", head.JobType)
+	}
+
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// FineTuningJobList represents a paginated list of fine-tuning jobs.
+type FineTuningJobList struct {
+	// Object is the object type, typically "list".
+	Object string `json:"object"`
+
+	// Data contains the jobs, as *CompletionJob or *ClassifierJob values.
+	Data []FineTuningJob `json:"data"`
+
+	// Total is the total number of jobs matching the filters.
+	Total int `json:"total"`
+}
+
+// UnmarshalJSON decodes the list, dispatching each job to its concrete type.
+func (l *FineTuningJobList) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Object string            `json:"object"`
+		Data   []json.RawMessage `json:"data"`
+		Total  int               `json:"total"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	jobs := make([]FineTuningJob, 0, len(raw.Data))
+	for _, item := range raw.Data {
+		job, err := unmarshalFineTuningJob(item)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+
+	l.Object = raw.Object
+	l.Data = jobs
+	l.Total = raw.Total
+	return nil
+}
+
+// ListFineTuningJobsParams represents optional parameters for filtering and paginating
+// fine-tuning job lists. All fields are optional; omit them or use zero values to use defaults.
+type ListFineTuningJobsParams struct {
+	// Page is the page number to retrieve (0-indexed). If 0, returns the first page.
+	Page int
+
+	// PageSize is the number of jobs to return per page. If 0, uses the API's default page size.
+	PageSize int
+
+	// Model filters jobs by base model.
+	Model string
+
+	// CreatedAfter filters out jobs created before this time. Ignored if zero.
+	CreatedAfter time.Time
+
+	// CreatedBefore filters out jobs created after this time. Ignored if zero.
+	CreatedBefore time.Time
+
+	// CreatedByMe restricts the results to jobs created by the API caller.
+	CreatedByMe bool
+
+	// Status filters jobs by state.
+	Status FineTuningJobStatus
+
+	// WandbProject filters jobs by Weights & Biases project.
+	WandbProject string
+
+	// WandbName filters jobs by Weights & Biases run name.
+	WandbName string
+
+	// Suffix filters jobs by model suffix.
+	Suffix string
+}
@@ -0,0 +1,312 @@
+package mistral
+
+import (
+	"io"
+	"time"
+)
+
+// LibraryRequest represents a request to create a document library.
+type LibraryRequest struct {
+	// Name is the name of the library. This is required.
+	Name string `json:"name"`
+
+	// Description is an optional description of the library.
+	Description string `json:"description,omitempty"`
+
+	// ChunkSize is the size of the chunks documents are split into for retrieval.
+	// If nil, the API default is used.
+	ChunkSize *int `json:"chunk_size,omitempty"`
+}
+
+// LibraryUpdateRequest represents a request to update a document library. Only the fields
+// that are set are updated.
+type LibraryUpdateRequest struct {
+	// Name is the new name of the library.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the library.
+	Description string `json:"description,omitempty"`
+}
+
+// Library represents a document library, a collection of documents agents can search with
+// the document_library tool.
+type Library struct {
+	// ID is the unique identifier of the library.
+	ID string `json:"id"`
+
+	// Name is the name of the library.
+	Name string `json:"name"`
+
+	// CreatedAt is the timestamp when the library was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the timestamp when the library was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// OwnerID is the ID of the user, workspace or organization owning the library.
+	OwnerID string `json:"owner_id"`
+
+	// OwnerType is the kind of owner, such as "User".
+	OwnerType string `json:"owner_type"`
+
+	// TotalSize is the total size of the documents in the library, in bytes.
+	TotalSize int64 `json:"total_size"`
+
+	// NbDocuments is the number of documents in the library.
+	NbDocuments int `json:"nb_documents"`
+
+	// ChunkSize is the size of the chunks documents are split into, if set.
+	ChunkSize *int `json:"chunk_size,omitempty"`
+
+	// Emoji is the emoji representing the library, if set.
+	Emoji string `json:"emoji,omitempty"`
+
+	// Description is the description of the library.
+	Description string `json:"description,omitempty"`
+
+	// GeneratedName is a name generated from the library's content.
+	GeneratedName string `json:"generated_name,omitempty"`
+
+	// GeneratedDescription is a description generated from the library's content.
+	GeneratedDescription string `json:"generated_description,omitempty"`
+
+	// ExplicitUserMembersCount is the number of users the library is shared with.
+	ExplicitUserMembersCount *int `json:"explicit_user_members_count,omitempty"`
+
+	// ExplicitWorkspaceMembersCount is the number of workspaces the library is shared with.
+	ExplicitWorkspaceMembersCount *int `json:"explicit_workspace_members_count,omitempty"`
+
+	// OrgSharingRole is the access level granted to the whole organization, if any.
+	OrgSharingRole string `json:"org_sharing_role,omitempty"`
+}
+
+// LibraryList represents the list of libraries returned by ListLibraries.
+type LibraryList struct {
+	// Data contains the libraries.
+	Data []Library `json:"data"`
+}
+
+// UploadLibraryDocumentRequest represents a request to upload a document to a library.
+type UploadLibraryDocumentRequest struct {
+	// File is an io.Reader providing the document content to upload.
+	File io.Reader
+
+	// Filename is the name of the document, including its extension (e.g., "handbook.pdf").
+	Filename string
+}
+
+// DocumentProcessingStatus is the processing state of a library document.
+type DocumentProcessingStatus string
+
+const (
+	// DocumentProcessingStatusRunning indicates the document is being processed.
+	DocumentProcessingStatusRunning DocumentProcessingStatus = "Running"
+
+	// DocumentProcessingStatusCompleted indicates the document was processed and is searchable.
+	DocumentProcessingStatusCompleted DocumentProcessingStatus = "Completed"
+
+	// DocumentProcessingStatusFailed indicates processing failed. Use ReprocessLibraryDocument
+	// to try again.
+	DocumentProcessingStatusFailed DocumentProcessingStatus = "Failed"
+)
+
+// LibraryDocument represents a document stored in a library.
+type LibraryDocument struct {
+	// ID is the unique identifier of the document.
+	ID string `json:"id"`
+
+	// LibraryID is the ID of the library containing the document.
+	LibraryID string `json:"library_id"`
+
+	// Hash is the hash of the document's content.
+	Hash string `json:"hash"`
+
+	// MimeType is the MIME type of the document.
+	MimeType string `json:"mime_type"`
+
+	// Extension is the file extension of the document.
+	Extension string `json:"extension"`
+
+	// Size is the size of the document in bytes.
+	Size int64 `json:"size"`
+
+	// Name is the name of the document.
+	Name string `json:"name"`
+
+	// Summary is a summary of the document, generated during processing.
+	Summary string `json:"summary,omitempty"`
+
+	// CreatedAt is the timestamp when the document was uploaded.
+	CreatedAt time.Time `json:"created_at"`
+
+	// LastProcessedAt is the timestamp when the document was last processed, if it has been.
+	LastProcessedAt *time.Time `json:"last_processed_at,omitempty"`
+
+	// NumberOfPages is the number of pages of the document, if known.
+	NumberOfPages *int `json:"number_of_pages,omitempty"`
+
+	// ProcessingStatus is the processing state of the document.
+	ProcessingStatus DocumentProcessingStatus `json:"processing_status"`
+
+	// UploadedByID is the ID of the user or workspace that uploaded the document.
+	UploadedByID string `json:"uploaded_by_id"`
+
+	// UploadedByType is the kind of uploader, such as "User".
+	UploadedByType string `json:"uploaded_by_type"`
+
+	// TokensProcessingMainContent is the number of tokens used to process the content.
+	TokensProcessingMainContent *int `json:"tokens_processing_main_content,omitempty"`
+
+	// TokensProcessingSummary is the number of tokens used to generate the summary.
+	TokensProcessingSummary *int `json:"tokens_processing_summary,omitempty"`
+
+	// TokensProcessingTotal is the total number of tokens used to process the document.
+	TokensProcessingTotal int `json:"tokens_processing_total"`
+}
+
+// PaginationInfo describes a page of results.
+type PaginationInfo struct {
+	// TotalItems is the total number of items matching the query.
+	TotalItems int `json:"total_items"`
+
+	// TotalPages is the total number of pages.
+	TotalPages int `json:"total_pages"`
+
+	// CurrentPage is the index of the current page.
+	CurrentPage int `json:"current_page"`
+
+	// PageSize is the number of items per page.
+	PageSize int `json:"page_size"`
+
+	// HasMore indicates whether there are more pages after this one.
+	HasMore bool `json:"has_more"`
+}
+
+// LibraryDocumentList represents a paginated list of library documents.
+type LibraryDocumentList struct {
+	// Pagination describes the returned page.
+	Pagination PaginationInfo `json:"pagination"`
+
+	// Data contains the documents.
+	Data []LibraryDocument `json:"data"`
+}
+
+// ListLibraryDocumentsParams represents optional parameters for searching, sorting and
+// paginating library documents. All fields are optional; omit them or use zero values to
+// use defaults.
+type ListLibraryDocumentsParams struct {
+	// Search filters documents by name.
+	Search string
+
+	// Page is the page number to retrieve (0-indexed). If 0, returns the first page.
+	Page int
+
+	// PageSize is the number of documents to return per page. If 0, uses the API's default page size.
+	PageSize int
+
+	// SortBy is the field to sort by (e.g., "created_at", "name"). If empty, sorts by creation time.
+	SortBy string
+
+	// SortOrder is the sort direction, "asc" or "desc". If empty, sorts in descending order.
+	SortOrder string
+}
+
+// LibraryDocumentUpdateRequest represents a request to update a library document.
+type LibraryDocumentUpdateRequest struct {
+	// Name is the new name of the document.
+	Name string `json:"name,omitempty"`
+}
+
+// DocumentTextContent contains the text extracted from a library document.
+type DocumentTextContent struct {
+	// Text is the extracted text.
+	Text string `json:"text"`
+}
+
+// ProcessingStatus represents the processing state of a library document.
+type ProcessingStatus struct {
+	// DocumentID is the ID of the document.
+	DocumentID string `json:"document_id"`
+
+	// ProcessingStatus is the processing state of the document.
+	ProcessingStatus DocumentProcessingStatus `json:"processing_status"`
+}
+
+// ShareLevel is the access level granted when sharing a library.
+type ShareLevel string
+
+const (
+	// ShareLevelViewer grants read access to the library.
+	ShareLevelViewer ShareLevel = "Viewer"
+
+	// ShareLevelEditor grants read and write access to the library.
+	ShareLevelEditor ShareLevel = "Editor"
+)
+
+// EntityType is the kind of entity a library is shared with.
+type EntityType string
+
+const (
+	// EntityTypeUser shares the library with a single user.
+	EntityTypeUser EntityType = "User"
+
+	// EntityTypeWorkspace shares the library with all members of a workspace.
+	EntityTypeWorkspace EntityType = "Workspace"
+
+	// EntityTypeOrg shares the library with the whole organization.
+	EntityTypeOrg EntityType = "Org"
+)
+
+// SharingRequest represents a request to grant or change an entity's access to a library.
+type SharingRequest struct {
+	// OrgID is the ID of the organization the library belongs to.
+	OrgID string `json:"org_id"`
+
+	// Level is the access level to grant.
+	Level ShareLevel `json:"level"`
+
+	// ShareWithUUID is the ID of the user, workspace or organization to share with.
+	ShareWithUUID string `json:"share_with_uuid"`
+
+	// ShareWithType is the kind of entity identified by ShareWithUUID.
+	ShareWithType EntityType `json:"share_with_type"`
+}
+
+// SharingDeleteRequest represents a request to revoke an entity's access to a library.
+type SharingDeleteRequest struct {
+	// OrgID is the ID of the organization the library belongs to.
+	OrgID string `json:"org_id"`
+
+	// ShareWithUUID is the ID of the user, workspace or organization to revoke access from.
+	ShareWithUUID string `json:"share_with_uuid"`
+
+	// ShareWithType is the kind of entity identified by ShareWithUUID.
+	ShareWithType EntityType `json:"share_with_type"`
+}
+
+// LibrarySharing describes an entity's access to a library.
+type LibrarySharing struct {
+	// LibraryID is the ID of the shared library.
+	LibraryID string `json:"library_id"`
+
+	// UserID is the ID of the user who granted the access, if known.
+	UserID string `json:"user_id,omitempty"`
+
+	// OrgID is the ID of the organization the library belongs to.
+	OrgID string `json:"org_id"`
+
+	// Role is the access level of the entity, such as "Viewer", "Editor" or "Owner".
+	Role string `json:"role"`
+
+	// ShareWithType is the kind of entity the library is shared with.
+	ShareWithType EntityType `json:"share_with_type"`
+
+	// ShareWithUUID is the ID of the entity the library is shared with.
+	ShareWithUUID string `json:"share_with_uuid"`
+}
+
+// LibrarySharingList represents the list of accesses returned by ListLibrarySharing.
+type LibrarySharingList struct {
+	// Data contains the accesses.
+	Data []LibrarySharing `json:"data"`
+}
@@ -1 +1,45 @@
 package mistral
+
+// ModelCapabilities describes the features supported by a model.
+type ModelCapabilities struct {
+	// CompletionChat indicates the model supports chat completions.
+	CompletionChat bool `json:"completion_chat"`
+
+	// CompletionFIM indicates the model supports fill-in-the-middle completions.
+	CompletionFIM bool `json:"completion_fim"`
+
+	// FunctionCalling indicates the model supports function/tool calling.
+	FunctionCalling bool `json:"function_calling"`
+
+	// FineTuning indicates the model can be fine-tuned.
+	FineTuning bool `json:"fine_tuning"`
+
+	// Vision indicates the model accepts image inputs.
+	Vision bool `json:"vision"`
+
+	// Classification indicates the model supports classification.
+	Classification bool `json:"classification"`
+}
+
+// UpdateFineTunedModelRequest represents a request to update the metadata of a fine-tuned model.
+// Only the fields that are set are changed.
+type UpdateFineTunedModelRequest struct {
+	// Name is the new human-readable name of the model.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the model.
+	Description string `json:"description,omitempty"`
+}
+
+// ArchiveModelResponse represents the API response after archiving or unarchiving a
+// fine-tuned model.
+type ArchiveModelResponse struct {
+	// ID is the unique identifier of the model.
+	ID string `json:"id"`
+
+	// Object is the object type, typically "model".
+	Object string `json:"object"`
+
+	// Archived indicates whether the model is now archived.
+	Archived bool `json:"archived"`
+}
@@ -0,0 +1,99 @@
+package mistral
+
+import "sort"
+
+// ModerationCategory is a category of content detected by the moderation API.
+type ModerationCategory string
+
+const (
+	// ModerationCategorySexual covers sexually explicit content.
+	ModerationCategorySexual ModerationCategory = "sexual"
+
+	// ModerationCategoryHateAndDiscrimination covers hateful or discriminatory content.
+	ModerationCategoryHateAndDiscrimination ModerationCategory = "hate_and_discrimination"
+
+	// ModerationCategoryViolenceAndThreats covers violent content and threats.
+	ModerationCategoryViolenceAndThreats ModerationCategory = "violence_and_threats"
+
+	// ModerationCategoryDangerousAndCriminalContent covers dangerous or criminal content.
+	ModerationCategoryDangerousAndCriminalContent ModerationCategory = "dangerous_and_criminal_content"
+
+	// ModerationCategorySelfHarm covers content promoting self-harm.
+	ModerationCategorySelfHarm ModerationCategory = "selfharm"
+
+	// ModerationCategoryHealth covers requests for health advice.
+	ModerationCategoryHealth ModerationCategory = "health"
+
+	// ModerationCategoryFinancial covers requests for financial advice.
+	ModerationCategoryFinancial ModerationCategory = "financial"
+
+	// ModerationCategoryLaw covers requests for legal advice.
+	ModerationCategoryLaw ModerationCategory = "law"
+
+	// ModerationCategoryPII covers personally identifiable information.
+	ModerationCategoryPII ModerationCategory = "pii"
+)
+
+// ModerationRequest represents a request to moderate raw text.
+type ModerationRequest struct {
+	// Model is the ID of the moderation model to use (e.g., "mistral-moderation-latest").
+	Model string `json:"model"`
+
+	// Input is the text to moderate, either a string or a []string to moderate several
+	// texts at once.
+	Input interface{} `json:"input"`
+}
+
+// ChatModerationRequest represents a request to moderate a conversation. The last message
+// of each conversation is classified in the context of the previous ones.
+type ChatModerationRequest struct {
+	// Model is the ID of the moderation model to use (e.g., "mistral-moderation-latest").
+	Model string `json:"model"`
+
+	// Input is the conversation to moderate, either a []ChatMessage or a [][]ChatMessage
+	// to moderate several conversations at once.
+	Input interface{} `json:"input"`
+}
+
+// ModerationResult contains the moderation verdict for a single input.
+type ModerationResult struct {
+	// Categories indicates, for each category, whether the input was flagged.
+	Categories map[ModerationCategory]bool `json:"categories"`
+
+	// CategoryScores contains, for each category, the confidence score between 0 and 1.
+	CategoryScores map[ModerationCategory]float64 `json:"category_scores"`
+}
+
+// Flagged reports whether the input was flagged in any category.
+func (r ModerationResult) Flagged() bool {
+	for _, flagged := range r.Categories {
+		if flagged {
+			return true
+		}
+	}
+	return false
+}
+
+// FlaggedCategories returns the categories the input was flagged in, in alphabetical order.
+func (r ModerationResult) FlaggedCategories() []ModerationCategory {
+	var categories []ModerationCategory
+	for category, flagged := range r.Categories {
+		if flagged {
+			categories = append(categories, category)
+		}
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i] < categories[j] })
+	return categories
+}
+
+// ModerationResponse represents a response from the moderation APIs.
+type ModerationResponse struct {
+	// ID is a unique identifier for this moderation request.
+	ID string `json:"id"`
+
+	// Model is the model used for moderation.
+	Model string `json:"model"`
+
+	// Results contains one result per input, in the same order as the inputs.
+	Results []ModerationResult `json:"results"`
+}
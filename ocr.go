@@ -0,0 +1,310 @@
+package mistral
+
+import (
+	"archive/zip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// OCRRequest represents a request to extract text and images from a document.
+type OCRRequest struct {
+	// Model is the ID of the OCR model to use (e.g., "mistral-ocr-latest").
+	Model string `json:"model"`
+
+	// ID is an optional identifier for the request.
+	ID string `json:"id,omitempty"`
+
+	// Document is the document to process: a DocumentURLChunk for PDFs and other documents,
+	// an ImageURLChunk for images, or a FileChunk for a file uploaded with UploadFile.
+	Document ContentChunk `json:"document"`
+
+	// Pages are the 0-indexed pages to process. If empty, all pages are processed.
+	Pages []int `json:"pages,omitempty"`
+
+	// IncludeImageBase64 requests the extracted images to be returned as base64 data URLs
+	// in OCRImageObject.ImageBase64.
+	IncludeImageBase64 bool `json:"include_image_base64,omitempty"`
+
+	// ImageLimit is the maximum number of images to extract. If 0, there is no limit.
+	ImageLimit int `json:"image_limit,omitempty"`
+
+	// ImageMinSize is the minimum height and width, in pixels, of the images to extract.
+	ImageMinSize int `json:"image_min_size,omitempty"`
+
+	// BBoxAnnotationFormat is the format of the annotation generated for each extracted
+	// image, returned in OCRImageObject.ImageAnnotation. Use a "json_schema" format to
+	// control the structure of the annotation.
+	BBoxAnnotationFormat *ResponseFormat `json:"bbox_annotation_format,omitempty"`
+
+	// DocumentAnnotationFormat is the format of the annotation generated for the whole
+	// document, returned in OCRResponse.DocumentAnnotation. Use a "json_schema" format to
+	// control the structure of the annotation.
+	DocumentAnnotationFormat *ResponseFormat `json:"document_annotation_format,omitempty"`
+}
+
+// OCRPageDimensions contains the dimensions of a page.
+type OCRPageDimensions struct {
+	// DPI is the resolution the page was rendered at, in dots per inch.
+	DPI int `json:"dpi"`
+
+	// Height is the height of the page in pixels.
+	Height int `json:"height"`
+
+	// Width is the width of the page in pixels.
+	Width int `json:"width"`
+}
+
+// OCRImageObject is an image extracted from a page. Coordinates are in pixels, relative to
+// the page's dimensions.
+type OCRImageObject struct {
+	// ID is the identifier of the image. It is the file name referenced by the page's
+	// Markdown, e.g. "img-0.jpeg".
+	ID string `json:"id"`
+
+	// TopLeftX is the x coordinate of the top-left corner of the image.
+	TopLeftX *int `json:"top_left_x"`
+
+	// TopLeftY is the y coordinate of the top-left corner of the image.
+	TopLeftY *int `json:"top_left_y"`
+
+	// BottomRightX is the x coordinate of the bottom-right corner of the image.
+	BottomRightX *int `json:"bottom_right_x"`
+
+	// BottomRightY is the y coordinate of the bottom-right corner of the image.
+	BottomRightY *int `json:"bottom_right_y"`
+
+	// ImageBase64 is the image as a base64 data URL. Only set if IncludeImageBase64 was
+	// set in the request.
+	ImageBase64 string `json:"image_base64,omitempty"`
+
+	// ImageAnnotation is the annotation of the image. Only set if BBoxAnnotationFormat was
+	// set in the request.
+	ImageAnnotation string `json:"image_annotation,omitempty"`
+}
+
+// OCRPageObject contains the content extracted from a single page.
+type OCRPageObject struct {
+	// Index is the 0-indexed page number.
+	Index int `json:"index"`
+
+	// Markdown is the content of the page as Markdown. Extracted images are referenced
+	// by their ID, e.g. "![img-0.jpeg](img-0.jpeg)".
+	Markdown string `json:"markdown"`
+
+	// Images are the images extracted from the page.
+	Images []OCRImageObject `json:"images"`
+
+	// Dimensions are the dimensions of the page, if known.
+	Dimensions *OCRPageDimensions `json:"dimensions"`
+}
+
+// OCRUsageInfo contains usage statistics for an OCR request.
+type OCRUsageInfo struct {
+	// PagesProcessed is the number of pages processed.
+	PagesProcessed int `json:"pages_processed"`
+
+	// DocSizeBytes is the size of the document in bytes, if known.
+	DocSizeBytes *int `json:"doc_size_bytes,omitempty"`
+}
+
+// OCRResponse represents a response from the OCR API.
+type OCRResponse struct {
+	// Pages contains the content of each processed page.
+	Pages []OCRPageObject `json:"pages"`
+
+	// Model is the model used for OCR.
+	Model string `json:"model"`
+
+	// DocumentAnnotation is the annotation of the whole document. Only set if
+	// DocumentAnnotationFormat was set in the request.
+	DocumentAnnotation string `json:"document_annotation,omitempty"`
+
+	// UsageInfo contains usage statistics for the request.
+	UsageInfo OCRUsageInfo `json:"usage_info"`
+}
+
+// OCRExportOptions configures how an OCRResponse is exported by ExportMarkdownDir and
+// ExportMarkdownZip. The zero value writes one Markdown file per page and stores the
+// images in an "images" directory.
+type OCRExportOptions struct {
+	// Combined writes all pages to a single Markdown file instead of one file per page.
+	Combined bool
+
+	// FileName is the name of the Markdown file when Combined is set.
+	// If empty, "document.md" is used.
+	FileName string
+
+	// ImageDir is the directory images are written to, relative to the Markdown files.
+	// If empty, "images" is used.
+	ImageDir string
+}
+
+// ocrBundleFile is a file of an exported OCR bundle.
+type ocrBundleFile struct {
+	name string
+	data []byte
+}
+
+// ExportMarkdownDir writes the response as Markdown files and image files in dir, creating
+// it if needed. Pages are written to "page-000.md", "page-001.md", etc., named after their
+// index, unless opts.Combined is set. Images are decoded from OCRImageObject.ImageBase64
+// and the Markdown references to them are rewritten so they resolve; images without
+// base64 data, because IncludeImageBase64 wasn't set in the request, are left as is.
+//
+// Example:
+//
+//	resp, err := client.ProcessOCR(ctx, &mistral.OCRRequest{
+//	    Model:              "mistral-ocr-latest",
+//	    Document:           mistral.DocumentURLChunk{DocumentURL: url},
+//	    IncludeImageBase64: true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	err = resp.ExportMarkdownDir("out/invoice", nil)
+func (r *OCRResponse) ExportMarkdownDir(dir string, opts *OCRExportOptions) error {
+	files, err := r.markdownBundle(opts)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		name := filepath.Join(dir, filepath.FromSlash(file.name))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, file.data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportMarkdownZip writes the response to w as a zip archive with the same layout as
+// ExportMarkdownDir.
+//
+// Example:
+//
+//	f, err := os.Create("invoice.zip")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	err = resp.ExportMarkdownZip(f, &mistral.OCRExportOptions{Combined: true})
+func (r *OCRResponse) ExportMarkdownZip(w io.Writer, opts *OCRExportOptions) error {
+	files, err := r.markdownBundle(opts)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(file.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// markdownBundle builds the files of an exported OCR bundle. Paths use forward slashes.
+func (r *OCRResponse) markdownBundle(opts *OCRExportOptions) ([]ocrBundleFile, error) {
+	if opts == nil {
+		opts = &OCRExportOptions{}
+	}
+	fileName := opts.FileName
+	if fileName == "" {
+		fileName = "document.md"
+	}
+	imageDir := opts.ImageDir
+	if imageDir == "" {
+		imageDir = "images"
+	}
+	imageDir = strings.Trim(filepath.ToSlash(imageDir), "/")
+
+	var files []ocrBundleFile
+	var pages []string
+	used := make(map[string]bool)
+
+	for _, page := range r.Pages {
+		markdown := page.Markdown
+		for _, image := range page.Images {
+			if image.ImageBase64 == "" {
+				continue
+			}
+			data, err := decodeImageBase64(image.ImageBase64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode image %q on page %d: %w", image.ID, page.Index, err)
+			}
+
+			// Image IDs are used as file names, so strip any directory components, reject
+			// names that could escape the output directory, and prefix repeated names with
+			// the page index, then a counter, until they are unique.
+			name := path.Base(image.ID)
+			if !isPlainFileName(name) {
+				return nil, fmt.Errorf("invalid image ID %q on page %d", image.ID, page.Index)
+			}
+			for n, base := 1, name; used[name]; n++ {
+				if n == 1 {
+					name = fmt.Sprintf("page-%03d-%s", page.Index, base)
+				} else {
+					name = fmt.Sprintf("page-%03d-%d-%s", page.Index, n, base)
+				}
+			}
+			used[name] = true
+
+			target := path.Join(imageDir, name)
+			files = append(files, ocrBundleFile{name: target, data: data})
+			markdown = strings.ReplaceAll(markdown, "]("+image.ID+")", "]("+target+")")
+		}
+
+		if opts.Combined {
+			pages = append(pages, markdown)
+		} else {
+			files = append(files, ocrBundleFile{
+				name: fmt.Sprintf("page-%03d.md", page.Index),
+				data: []byte(markdown),
+			})
+		}
+	}
+
+	if opts.Combined {
+		files = append(files, ocrBundleFile{
+			name: fileName,
+			data: []byte(strings.Join(pages, "\n\n")),
+		})
+	}
+	return files, nil
+}
+
+// isPlainFileName reports whether name can be used as a file name within a directory on
+// any platform: it must not be empty, "." or "..", and must not contain path separators
+// or a volume name.
+func isPlainFileName(name string) bool {
+	switch name {
+	case "", ".", "..":
+		return false
+	}
+	return !strings.ContainsAny(name, `/\:`)
+}
+
+// decodeImageBase64 decodes an image given either as a base64 data URL or as raw base64.
+func decodeImageBase64(s string) ([]byte, error) {
+	if strings.HasPrefix(s, "data:") {
+		i := strings.Index(s, ",")
+		if i < 0 {
+			return nil, errors.New("malformed data URL")
+		}
+		s = s[i+1:]
+	}
+	return base64.StdEncoding.DecodeString(s)
+}
@@ -0,0 +1,138 @@
+package mistral
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOCRResponse() *OCRResponse {
+	img := base64.StdEncoding.EncodeToString([]byte("jpeg-bytes"))
+	return &OCRResponse{
+		Model: "mistral-ocr-latest",
+		Pages: []OCRPageObject{
+			{
+				Index:    0,
+				Markdown: "# Page 1\n\n![img-0.jpeg](img-0.jpeg)",
+				Images:   []OCRImageObject{{ID: "img-0.jpeg", ImageBase64: "data:image/jpeg;base64," + img}},
+			},
+			{
+				Index:    1,
+				Markdown: "# Page 2\n\n![img-0.jpeg](img-0.jpeg)\n\n![img-1.jpeg](img-1.jpeg)",
+				Images: []OCRImageObject{
+					{ID: "img-0.jpeg", ImageBase64: img},
+					{ID: "img-1.jpeg"},
+				},
+			},
+		},
+	}
+}
+
+func TestOCRExportMarkdownDir(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, testOCRResponse().ExportMarkdownDir(dir, nil))
+
+	page0, err := os.ReadFile(filepath.Join(dir, "page-000.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Page 1\n\n![img-0.jpeg](images/img-0.jpeg)", string(page0))
+
+	page1, err := os.ReadFile(filepath.Join(dir, "page-001.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Page 2\n\n![img-0.jpeg](images/page-001-img-0.jpeg)\n\n![img-1.jpeg](img-1.jpeg)", string(page1))
+
+	for _, name := range []string{"img-0.jpeg", "page-001-img-0.jpeg"} {
+		data, err := os.ReadFile(filepath.Join(dir, "images", name))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg-bytes", string(data))
+	}
+	_, err = os.Stat(filepath.Join(dir, "images", "img-1.jpeg"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestOCRExportMarkdownZipCombined(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, testOCRResponse().ExportMarkdownZip(&buf, &OCRExportOptions{
+		Combined: true,
+		FileName: "invoice.md",
+		ImageDir: "assets",
+	}))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(data)
+	}
+
+	assert.Equal(t, map[string]string{
+		"assets/img-0.jpeg":          "jpeg-bytes",
+		"assets/page-001-img-0.jpeg": "jpeg-bytes",
+		"invoice.md": "# Page 1\n\n![img-0.jpeg](assets/img-0.jpeg)\n\n" +
+			"# Page 2\n\n![img-0.jpeg](assets/page-001-img-0.jpeg)\n\n![img-1.jpeg](img-1.jpeg)",
+	}, files)
+}
+
+func TestOCRExportDisambiguatesImageNames(t *testing.T) {
+	img := base64.StdEncoding.EncodeToString([]byte("jpeg-bytes"))
+	resp := &OCRResponse{Pages: []OCRPageObject{
+		{Index: 0, Images: []OCRImageObject{{ID: "x.jpeg", ImageBase64: img}, {ID: "page-001-x.jpeg", ImageBase64: img}}},
+		{Index: 1, Images: []OCRImageObject{{ID: "x.jpeg", ImageBase64: img}, {ID: "x.jpeg", ImageBase64: img}}},
+	}}
+
+	files, err := resp.markdownBundle(&OCRExportOptions{Combined: true})
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range files {
+		names = append(names, f.name)
+	}
+	assert.Equal(t, []string{
+		"images/x.jpeg",
+		"images/page-001-x.jpeg",
+		"images/page-001-2-x.jpeg",
+		"images/page-001-3-x.jpeg",
+		"document.md",
+	}, names)
+}
+
+func TestOCRExportRejectsInvalidImageData(t *testing.T) {
+	resp := &OCRResponse{Pages: []OCRPageObject{{
+		Markdown: "![x](x.png)",
+		Images:   []OCRImageObject{{ID: "x.png", ImageBase64: "not base64!"}},
+	}}}
+
+	assert.Error(t, resp.ExportMarkdownDir(t.TempDir(), nil))
+}
+
+func TestOCRExportRejectsUnsafeImageIDs(t *testing.T) {
+	img := base64.StdEncoding.EncodeToString([]byte("jpeg-bytes"))
+	for _, id := range []string{"..", "images/..", `..\..\evil.exe`, `C:evil.exe`, "/"} {
+		resp := &OCRResponse{Pages: []OCRPageObject{{
+			Markdown: "![x](" + id + ")",
+			Images:   []OCRImageObject{{ID: id, ImageBase64: img}},
+		}}}
+
+		dir := t.TempDir()
+		assert.Error(t, resp.ExportMarkdownDir(dir, nil), id)
+		assert.Error(t, resp.ExportMarkdownZip(io.Discard, nil), id)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries, id)
+	}
+}
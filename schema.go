@@ -0,0 +1,450 @@
+package mistral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSchemaNameLength is the longest JSON schema name CreateStructuredCompletion derives.
+const maxSchemaNameLength = 64
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// NewJSONSchema returns a strict JSON schema describing the JSON encoding of T, for use in a
+// ResponseFormat of type "json_schema". T is typically a struct. The schema is derived from
+// the fields of T with the same rules as encoding/json, plus the following:
+//
+//   - Fields are required unless their json tag has the omitempty option. Required pointer
+//     fields are nullable, as encoding/json encodes nil pointers as null.
+//   - The description tag sets the description of a field.
+//   - The enum tag restricts a field, or the items of a slice or array field, to a
+//     comma-separated list of values.
+//   - Structs, including nested and embedded ones, don't allow additional properties.
+//
+// Recursive types, channels, functions and maps with non-string keys are not supported.
+//
+// Example:
+//
+//	type Invoice struct {
+//	    Number   string   `json:"number" description:"The invoice number"`
+//	    Currency string   `json:"currency" enum:"EUR,USD"`
+//	    Total    float64  `json:"total"`
+//	    Lines    []string `json:"lines,omitempty"`
+//	}
+//
+//	schema, err := mistral.NewJSONSchema[Invoice]("invoice")
+//	if err != nil {
+//	    return err
+//	}
+//	format := &mistral.ResponseFormat{Type: "json_schema", JSONSchema: schema}
+func NewJSONSchema[T any](name string) (*JSONSchema, error) {
+	schema, err := reflectSchema(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return &JSONSchema{Name: name, Schema: schema, Strict: true}, nil
+}
+
+// reflectSchema returns the JSON schema of the JSON encoding of t.
+func reflectSchema(t reflect.Type) (map[string]interface{}, error) {
+	return (&schemaReflector{visiting: make(map[reflect.Type]bool)}).schema(t)
+}
+
+// schemaReflector derives JSON schemas from Go types, detecting recursive types.
+type schemaReflector struct {
+	visiting map[reflect.Type]bool
+}
+
+// schema returns the JSON schema of t.
+func (r *schemaReflector) schema(t reflect.Type) (map[string]interface{}, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}, nil
+	case rawMessageType:
+		return map[string]interface{}{}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}, nil
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}, nil
+	case reflect.Interface:
+		return map[string]interface{}{}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes byte slices as base64 strings.
+			return map[string]interface{}{"type": "string"}, nil
+		}
+		items, err := r.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "array", "items": items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %s", t.Key())
+		}
+		values, err := r.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		return r.structSchema(t)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// structSchema returns the JSON schema of the struct type t.
+func (r *schemaReflector) structSchema(t reflect.Type) (map[string]interface{}, error) {
+	if r.visiting[t] {
+		return nil, fmt.Errorf("recursive type %s is not supported", t)
+	}
+	r.visiting[t] = true
+	defer delete(r.visiting, t)
+
+	properties := make(map[string]interface{})
+	required := []string{}
+	for _, f := range structFields(t) {
+		schema, err := r.fieldSchema(f)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.field.Name, err)
+		}
+
+		properties[f.name] = schema
+		if !hasTagOption(f.options, "omitempty") {
+			if f.field.Type.Kind() == reflect.Ptr {
+				// encoding/json encodes nil pointers as null.
+				nullable(schema)
+			}
+			required = append(required, f.name)
+		}
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}, nil
+}
+
+// fieldSchema returns the JSON schema of a struct field, including its description and
+// enum tags and the string option of its json tag.
+func (r *schemaReflector) fieldSchema(f schemaField) (map[string]interface{}, error) {
+	field := f.field
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	var schema map[string]interface{}
+	quoted := hasTagOption(f.options, "string") && isQuotable(fieldType)
+	if quoted {
+		// encoding/json encodes the value as a JSON string.
+		schema = map[string]interface{}{"type": "string"}
+	} else {
+		var err error
+		if schema, err = r.schema(field.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	if description := field.Tag.Get("description"); description != "" {
+		schema["description"] = description
+	}
+	if enum := field.Tag.Get("enum"); enum != "" {
+		// The enum of a slice or array applies to its items.
+		target := schema
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			target = items
+			fieldType = fieldType.Elem()
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+		}
+
+		values, err := enumValues(fieldType, enum)
+		if err != nil {
+			return nil, err
+		}
+		if quoted {
+			for i, v := range values {
+				data, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				values[i] = string(data)
+			}
+		}
+		target["enum"] = values
+	}
+	return schema, nil
+}
+
+// isQuotable reports whether the string option of a json tag applies to fields of type t.
+func isQuotable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// nullable allows null values in schema.
+func nullable(schema map[string]interface{}) {
+	if typ, ok := schema["type"].(string); ok {
+		schema["type"] = []string{typ, "null"}
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		schema["enum"] = append(enum, nil)
+	}
+}
+
+// schemaField is a struct field encoded by encoding/json.
+type schemaField struct {
+	name    string
+	options string
+	tagged  bool
+	depth   int
+	field   reflect.StructField
+}
+
+// structFields returns the fields encoding/json encodes for the struct type t, in field
+// order. Fields of embedded structs are promoted, and conflicting names are resolved like
+// encoding/json does: the shallowest field wins, then the only tagged one, and fields that
+// remain ambiguous are dropped.
+func structFields(t reflect.Type) []schemaField {
+	var fields []schemaField
+	collectFields(t, 0, make(map[reflect.Type]bool), &fields)
+
+	byName := make(map[string][]int)
+	for i, f := range fields {
+		byName[f.name] = append(byName[f.name], i)
+	}
+
+	var dominant []schemaField
+	for i, f := range fields {
+		if dominantField(fields, byName[f.name]) == i {
+			dominant = append(dominant, f)
+		}
+	}
+	return dominant
+}
+
+// collectFields appends the fields of the struct type t, and those of its embedded structs,
+// to fields.
+func collectFields(t reflect.Type, depth int, visiting map[reflect.Type]bool, fields *[]schemaField) {
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if !field.IsExported() && fieldType.Kind() != reflect.Struct {
+				continue
+			}
+			if name == "" && fieldType.Kind() == reflect.Struct {
+				if !visiting[fieldType] {
+					collectFields(fieldType, depth+1, visiting, fields)
+				}
+				continue
+			}
+		} else if !field.IsExported() {
+			continue
+		}
+
+		tagged := name != ""
+		if !tagged {
+			name = field.Name
+		}
+		*fields = append(*fields, schemaField{name: name, options: options, tagged: tagged, depth: depth, field: field})
+	}
+}
+
+// dominantField returns the index of the field that encoding/json encodes among the fields
+// at indexes, which share the same name, or -1 if they are ambiguous.
+func dominantField(fields []schemaField, indexes []int) int {
+	best, ambiguous := -1, false
+	for _, i := range indexes {
+		f := fields[i]
+		switch {
+		case best < 0 || f.depth < fields[best].depth ||
+			f.depth == fields[best].depth && f.tagged && !fields[best].tagged:
+			best, ambiguous = i, false
+		case f.depth == fields[best].depth && f.tagged == fields[best].tagged:
+			ambiguous = true
+		}
+	}
+	if ambiguous {
+		return -1
+	}
+	return best
+}
+
+// enumValues parses the comma-separated values of an enum tag as values of type t.
+func enumValues(t reflect.Type, enum string) ([]interface{}, error) {
+	var values []interface{}
+	for _, s := range strings.Split(enum, ",") {
+		s = strings.TrimSpace(s)
+		switch t.Kind() {
+		case reflect.String:
+			values = append(values, s)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid enum value %q: %w", s, err)
+			}
+			values = append(values, v)
+		case reflect.Float32, reflect.Float64:
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid enum value %q: %w", s, err)
+			}
+			values = append(values, v)
+		default:
+			return nil, fmt.Errorf("enum is not supported for type %s", t)
+		}
+	}
+	return values, nil
+}
+
+// hasTagOption reports whether the comma-separated options of a struct tag contain option.
+func hasTagOption(options, option string) bool {
+	for _, o := range strings.Split(options, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// StructuredOutputError is returned by CreateStructuredCompletion when the model's response
+// can't be decoded into the requested type.
+type StructuredOutputError struct {
+	// Raw is the text of the response.
+	Raw string
+
+	// Err is the decoding error.
+	Err error
+}
+
+// Error implements the error interface for StructuredOutputError.
+func (e *StructuredOutputError) Error() string {
+	return fmt.Sprintf("failed to decode structured output: %v", e.Err)
+}
+
+// Unwrap returns the decoding error.
+func (e *StructuredOutputError) Unwrap() error {
+	return e.Err
+}
+
+// CreateStructuredCompletion sends a chat completion request whose response must follow the
+// JSON schema of T, and decodes the first choice into a T. The schema is derived from T with
+// NewJSONSchema, named after T with characters other than letters, digits, "_" and "-"
+// replaced by underscores, and sent in strict mode, replacing req.ResponseFormat.
+// It is a function rather than a Client method because Go methods can't have type parameters.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - c: The client used to send the request
+//   - req: The chat completion request. It is not modified
+//
+// Returns:
+//   - The decoded response and the raw ChatCompletionResponse, including usage statistics
+//   - A *StructuredOutputError holding the raw text if the response can't be decoded into T,
+//     or an error if T is not supported or the request fails
+//
+// Example:
+//
+//	type Sentiment struct {
+//	    Label      string  `json:"label" enum:"positive,neutral,negative"`
+//	    Confidence float64 `json:"confidence" description:"Between 0 and 1"`
+//	}
+//
+//	sentiment, _, err := mistral.CreateStructuredCompletion[Sentiment](ctx, client, &mistral.ChatCompletionRequest{
+//	    Model:    "mistral-small-latest",
+//	    Messages: []mistral.ChatMessage{{Role: mistral.RoleUser, Content: mistral.TextContent(review)}},
+//	})
+//	var outErr *mistral.StructuredOutputError
+//	if errors.As(err, &outErr) {
+//	    log.Printf("invalid output: %s", outErr.Raw)
+//	}
+func CreateStructuredCompletion[T any](ctx context.Context, c *Client, req *ChatCompletionRequest) (*T, *ChatCompletionResponse, error) {
+	schema, err := NewJSONSchema[T](schemaName(reflect.TypeOf((*T)(nil)).Elem()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := *req
+	r.ResponseFormat = &ResponseFormat{Type: "json_schema", JSONSchema: schema}
+
+	resp, err := c.CreateChatCompletion(ctx, &r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, resp, &StructuredOutputError{Err: errors.New("response has no choices")}
+	}
+
+	raw := resp.Choices[0].Message.Content.Text()
+	var result T
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return nil, resp, &StructuredOutputError{Raw: raw, Err: err}
+	}
+	return &result, resp, nil
+}
+
+// schemaName returns the name of t with the characters not allowed in JSON schema names
+// replaced by underscores, or "response" if t is unnamed.
+func schemaName(t reflect.Type) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, t.Name())
+	name = strings.Trim(name, "_")
+	if len(name) > maxSchemaNameLength {
+		name = name[:maxSchemaNameLength]
+	}
+	if name == "" {
+		return "response"
+	}
+	return name
+}
@@ -0,0 +1,221 @@
+package mistral
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaAddress struct {
+	City    string `json:"city"`
+	Country string `json:"country,omitempty" description:"ISO 3166 country code"`
+}
+
+type schemaAudit struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type schemaPerson struct {
+	schemaAudit
+	Name      string            `json:"name" description:"Full name"`
+	Role      string            `json:"role" enum:"admin, member"`
+	Level     int               `json:"level" enum:"1,2,3"`
+	Score     *float64          `json:"score,omitempty"`
+	Tags      []string          `json:"tags"`
+	Addresses []schemaAddress   `json:"addresses"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Extra     interface{}       `json:"extra,omitempty"`
+	Ignored   string            `json:"-"`
+	NoTag     bool
+	private   string
+}
+
+func TestNewJSONSchema(t *testing.T) {
+	schema, err := NewJSONSchema[schemaPerson]("person")
+	require.NoError(t, err)
+	assert.Equal(t, "person", schema.Name)
+	assert.True(t, schema.Strict)
+
+	data, err := json.Marshal(schema.Schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["created_at", "name", "role", "level", "tags", "addresses", "NoTag"],
+		"properties": {
+			"created_at": {"type": "string", "format": "date-time"},
+			"name": {"type": "string", "description": "Full name"},
+			"role": {"type": "string", "enum": ["admin", "member"]},
+			"level": {"type": "integer", "enum": [1, 2, 3]},
+			"score": {"type": "number"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"addresses": {"type": "array", "items": {
+				"type": "object",
+				"additionalProperties": false,
+				"required": ["city"],
+				"properties": {
+					"city": {"type": "string"},
+					"country": {"type": "string", "description": "ISO 3166 country code"}
+				}
+			}},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"extra": {},
+			"NoTag": {"type": "boolean"}
+		}
+	}`, string(data))
+}
+
+type schemaNode struct {
+	Children []schemaNode `json:"children"`
+}
+
+func TestNewJSONSchemaUnsupported(t *testing.T) {
+	_, err := NewJSONSchema[schemaNode]("node")
+	assert.ErrorContains(t, err, "recursive type")
+
+	_, err = NewJSONSchema[map[int]string]("map")
+	assert.ErrorContains(t, err, "unsupported map key type")
+
+	_, err = NewJSONSchema[struct {
+		Ready bool `json:"ready" enum:"true"`
+	}]("enum")
+	assert.ErrorContains(t, err, "enum is not supported")
+}
+
+type schemaBase struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Kind string
+}
+
+type schemaOther struct {
+	Kind  string
+	Label string `json:"label"`
+}
+
+type schemaShadowed struct {
+	schemaBase
+	*schemaOther
+	Name  string `json:"name"`
+	Label string
+}
+
+func TestNewJSONSchemaEmbeddedConflicts(t *testing.T) {
+	schema, err := NewJSONSchema[schemaShadowed]("shadowed")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(schema.Schema)
+	require.NoError(t, err)
+	// Name and Label of schemaShadowed shadow the embedded fields, and Kind is ambiguous.
+	assert.JSONEq(t, `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["id", "label", "name", "Label"],
+		"properties": {
+			"id": {"type": "string"},
+			"label": {"type": "string"},
+			"name": {"type": "string"},
+			"Label": {"type": "string"}
+		}
+	}`, string(data))
+
+	encoded, err := json.Marshal(schemaShadowed{schemaOther: &schemaOther{}})
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	assert.ElementsMatch(t, schema.Schema["required"], keys(fields))
+}
+
+func keys(m map[string]interface{}) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type schemaOptional struct {
+	Note    *string        `json:"note"`
+	Status  *string        `json:"status" enum:"open,closed"`
+	Address *schemaAddress `json:"address"`
+	Score   *float64       `json:"score,omitempty"`
+}
+
+func TestNewJSONSchemaNullablePointers(t *testing.T) {
+	schema, err := NewJSONSchema[schemaOptional]("optional")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(schema.Schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["note", "status", "address"],
+		"properties": {
+			"note": {"type": ["string", "null"]},
+			"status": {"type": ["string", "null"], "enum": ["open", "closed", null]},
+			"address": {
+				"type": ["object", "null"],
+				"additionalProperties": false,
+				"required": ["city"],
+				"properties": {
+					"city": {"type": "string"},
+					"country": {"type": "string", "description": "ISO 3166 country code"}
+				}
+			},
+			"score": {"type": "number"}
+		}
+	}`, string(data))
+}
+
+type schemaPage[T any] struct {
+	Items []T `json:"items"`
+}
+
+func TestSchemaName(t *testing.T) {
+	assert.Equal(t, "schemaPerson", schemaName(reflect.TypeOf(schemaPerson{})))
+	assert.Equal(t, "response", schemaName(reflect.TypeOf([]schemaPerson{})))
+	assert.Regexp(t, `^schemaPage_[A-Za-z0-9_-]*schemaAddress$`, schemaName(reflect.TypeOf(schemaPage[schemaAddress]{})))
+	assert.LessOrEqual(t, len(schemaName(reflect.TypeOf(schemaPage[schemaPage[schemaPage[schemaAddress]]]{}))), 64)
+}
+
+type schemaTagged struct {
+	Tags     []string `json:"tags" enum:"a,b"`
+	Levels   [2]*int  `json:"levels" enum:"1,2"`
+	ID       int64    `json:"id,string"`
+	Priority int      `json:"priority,string" enum:"1,2,3"`
+	Ratio    *float64 `json:"ratio,string"`
+	Name     string   `json:"name,string"`
+	Ignored  []int    `json:"ignored,string"`
+}
+
+func TestNewJSONSchemaTagOptions(t *testing.T) {
+	schema, err := NewJSONSchema[schemaTagged]("tagged")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(schema.Schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["tags", "levels", "id", "priority", "ratio", "name", "ignored"],
+		"properties": {
+			"tags": {"type": "array", "items": {"type": "string", "enum": ["a", "b"]}},
+			"levels": {"type": "array", "items": {"type": "integer", "enum": [1, 2]}},
+			"id": {"type": "string"},
+			"priority": {"type": "string", "enum": ["1", "2", "3"]},
+			"ratio": {"type": ["string", "null"]},
+			"name": {"type": "string"},
+			"ignored": {"type": "array", "items": {"type": "integer"}}
+		}
+	}`, string(data))
+
+	ratio := 0.5
+	encoded, err := json.Marshal(schemaTagged{ID: 7, Priority: 2, Ratio: &ratio})
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"id":"7","priority":"2","ratio":"0.5"`)
+}
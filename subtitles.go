@@ -0,0 +1,239 @@
+package mistral
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// webVTTEscaper escapes cue text for WebVTT, where "<" and "&" start markup and character
+// references, and "-->" must not appear.
+var webVTTEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// errNoSegments is returned when formatting subtitles for a transcription without segments.
+var errNoSegments = errors.New("transcription has no segments; request TimestampGranularitySegment")
+
+// SubtitleOptions configures how transcription segments are turned into subtitle cues by
+// WriteSRT and WriteWebVTT. The zero value writes one cue per segment, on a single line.
+type SubtitleOptions struct {
+	// MaxLineLength wraps the text of a cue into lines of at most this many characters.
+	// Words longer than the limit are kept whole on their own line. If 0, text isn't wrapped.
+	MaxLineLength int
+
+	// MaxLinesPerCue splits segments whose wrapped text has more lines than this into
+	// several consecutive cues. The segment's time range is divided between the cues in
+	// proportion to their length. If 0, segments are never split. Only used with MaxLineLength.
+	MaxLinesPerCue int
+}
+
+// TextOptions configures how a transcription is written as plain text by WriteText.
+type TextOptions struct {
+	// ParagraphGap starts a new paragraph when the silence between two segments is at least
+	// this long. If 0, all segments are merged into a single paragraph.
+	ParagraphGap time.Duration
+
+	// MaxLineLength wraps paragraphs into lines of at most this many characters.
+	// If 0, text isn't wrapped.
+	MaxLineLength int
+}
+
+// subtitleCue is a single subtitle with its time range in seconds.
+type subtitleCue struct {
+	start, end float64
+	lines      []string
+}
+
+// WriteSRT writes the segments of the transcription to w as a SubRip (.srt) subtitle file.
+// The transcription must have been requested with TimestampGranularitySegment.
+//
+// Example:
+//
+//	f, err := os.Create("call.srt")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	err = resp.WriteSRT(f, &mistral.SubtitleOptions{MaxLineLength: 42, MaxLinesPerCue: 2})
+func (r *TranscriptionResponse) WriteSRT(w io.Writer, opts *SubtitleOptions) error {
+	cues, err := r.subtitleCues(opts)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	for i, cue := range cues {
+		fmt.Fprintf(bw, "%d\n%s --> %s\n%s\n\n", i+1,
+			formatSubtitleTime(cue.start, ','), formatSubtitleTime(cue.end, ','),
+			strings.Join(cue.lines, "\n"))
+	}
+	return bw.Flush()
+}
+
+// WriteWebVTT writes the segments of the transcription to w as a WebVTT (.vtt) subtitle file.
+// The transcription must have been requested with TimestampGranularitySegment. The text is
+// escaped, so that "<", ">" and "&" are displayed as-is.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := resp.WriteWebVTT(&buf, &mistral.SubtitleOptions{MaxLineLength: 42})
+func (r *TranscriptionResponse) WriteWebVTT(w io.Writer, opts *SubtitleOptions) error {
+	cues, err := r.subtitleCues(opts)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("WEBVTT\n\n")
+	for _, cue := range cues {
+		fmt.Fprintf(bw, "%s --> %s\n%s\n\n",
+			formatSubtitleTime(cue.start, '.'), formatSubtitleTime(cue.end, '.'),
+			webVTTEscaper.Replace(strings.Join(cue.lines, "\n")))
+	}
+	return bw.Flush()
+}
+
+// WriteText writes the transcription to w as plain text. Segments are merged into
+// paragraphs separated by blank lines, breaking on pauses of at least opts.ParagraphGap.
+// If the transcription has no segments, its full text is written as a single paragraph.
+//
+// Example:
+//
+//	err := resp.WriteText(os.Stdout, &mistral.TextOptions{
+//	    ParagraphGap:  2 * time.Second,
+//	    MaxLineLength: 80,
+//	})
+func (r *TranscriptionResponse) WriteText(w io.Writer, opts *TextOptions) error {
+	if opts == nil {
+		opts = &TextOptions{}
+	}
+
+	var paragraphs []string
+	if len(r.Segments) == 0 {
+		if text := strings.TrimSpace(r.Text); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	} else {
+		var current []string
+		var lastEnd float64
+		for _, segment := range r.Segments {
+			text := strings.TrimSpace(segment.Text)
+			if text == "" {
+				continue
+			}
+			gap := time.Duration((segment.Start - lastEnd) * float64(time.Second))
+			if len(current) > 0 && opts.ParagraphGap > 0 && gap >= opts.ParagraphGap {
+				paragraphs = append(paragraphs, strings.Join(current, " "))
+				current = nil
+			}
+			current = append(current, text)
+			lastEnd = segment.End
+		}
+		if len(current) > 0 {
+			paragraphs = append(paragraphs, strings.Join(current, " "))
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for i, paragraph := range paragraphs {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		for _, line := range wrapText(paragraph, opts.MaxLineLength) {
+			bw.WriteString(line)
+			bw.WriteString("\n")
+		}
+	}
+	return bw.Flush()
+}
+
+// subtitleCues converts the segments of the transcription into subtitle cues.
+func (r *TranscriptionResponse) subtitleCues(opts *SubtitleOptions) ([]subtitleCue, error) {
+	if len(r.Segments) == 0 {
+		return nil, errNoSegments
+	}
+	if opts == nil {
+		opts = &SubtitleOptions{}
+	}
+
+	var cues []subtitleCue
+	for _, segment := range r.Segments {
+		lines := wrapText(strings.TrimSpace(segment.Text), opts.MaxLineLength)
+		if len(lines) == 0 {
+			continue
+		}
+
+		perCue := len(lines)
+		if opts.MaxLineLength > 0 && opts.MaxLinesPerCue > 0 && opts.MaxLinesPerCue < perCue {
+			perCue = opts.MaxLinesPerCue
+		}
+
+		// Divide the segment's time range between its cues in proportion to their length.
+		total := 0
+		for _, line := range lines {
+			total += utf8.RuneCountInString(line)
+		}
+		duration := segment.End - segment.Start
+		done := 0
+		for i := 0; i < len(lines); i += perCue {
+			end := i + perCue
+			if end > len(lines) {
+				end = len(lines)
+			}
+			group := lines[i:end]
+
+			start := segment.Start + duration*float64(done)/float64(total)
+			for _, line := range group {
+				done += utf8.RuneCountInString(line)
+			}
+			cueEnd := segment.Start + duration*float64(done)/float64(total)
+			if end == len(lines) {
+				cueEnd = segment.End
+			}
+
+			cues = append(cues, subtitleCue{start: start, end: cueEnd, lines: group})
+		}
+	}
+	return cues, nil
+}
+
+// wrapText splits text into lines of at most maxLength characters, breaking between words.
+// If maxLength is 0, the text is returned as a single line with whitespace normalized.
+func wrapText(text string, maxLength int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+	if maxLength <= 0 {
+		return []string{strings.Join(words, " ")}
+	}
+
+	var lines []string
+	line := words[0]
+	length := utf8.RuneCountInString(line)
+	for _, word := range words[1:] {
+		wordLength := utf8.RuneCountInString(word)
+		if length+1+wordLength > maxLength {
+			lines = append(lines, line)
+			line, length = word, wordLength
+			continue
+		}
+		line += " " + word
+		length += 1 + wordLength
+	}
+	return append(lines, line)
+}
+
+// formatSubtitleTime formats a time in seconds as HH:MM:SS followed by sep and milliseconds.
+func formatSubtitleTime(seconds float64, sep byte) string {
+	ms := int64(math.Round(seconds * 1000))
+	if ms < 0 {
+		ms = 0
+	}
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
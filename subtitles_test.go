@@ -0,0 +1,87 @@
+package mistral
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTranscription() *TranscriptionResponse {
+	return &TranscriptionResponse{
+		Text: "Hello and welcome. How can I help you today? I'd like to cancel.",
+		Segments: []TranscriptionSegmentChunk{
+			{Text: " Hello and welcome.", Start: 0, End: 1.5},
+			{Text: " How can I help you today?", Start: 1.5, End: 3.25},
+			{Text: " ", Start: 3.25, End: 4},
+			{Text: " I'd like to cancel.", Start: 6, End: 3661.5},
+		},
+	}
+}
+
+func TestWriteSRT(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, testTranscription().WriteSRT(&buf, nil))
+
+	assert.Equal(t, "1\n00:00:00,000 --> 00:00:01,500\nHello and welcome.\n\n"+
+		"2\n00:00:01,500 --> 00:00:03,250\nHow can I help you today?\n\n"+
+		"3\n00:00:06,000 --> 01:01:01,500\nI'd like to cancel.\n\n", buf.String())
+}
+
+func TestWriteWebVTTSplitsSegments(t *testing.T) {
+	resp := &TranscriptionResponse{Segments: []TranscriptionSegmentChunk{
+		{Text: "aaaa bbbb cccc dddd", Start: 10, End: 14},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, resp.WriteWebVTT(&buf, &SubtitleOptions{MaxLineLength: 9, MaxLinesPerCue: 1}))
+
+	assert.Equal(t, "WEBVTT\n\n"+
+		"00:00:10.000 --> 00:00:12.000\naaaa bbbb\n\n"+
+		"00:00:12.000 --> 00:00:14.000\ncccc dddd\n\n", buf.String())
+}
+
+func TestWriteWebVTTEscapesText(t *testing.T) {
+	resp := &TranscriptionResponse{Segments: []TranscriptionSegmentChunk{
+		{Text: "Type <b> & press --> to go", Start: 0, End: 2},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, resp.WriteWebVTT(&buf, nil))
+
+	assert.Equal(t, "WEBVTT\n\n"+
+		"00:00:00.000 --> 00:00:02.000\nType &lt;b&gt; &amp; press --&gt; to go\n\n", buf.String())
+}
+
+func TestWriteSubtitlesWrapsLines(t *testing.T) {
+	resp := &TranscriptionResponse{Segments: []TranscriptionSegmentChunk{
+		{Text: "one two three extraordinarily four", Start: 0, End: 2},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, resp.WriteSRT(&buf, &SubtitleOptions{MaxLineLength: 8}))
+
+	assert.Equal(t, "1\n00:00:00,000 --> 00:00:02,000\none two\nthree\nextraordinarily\nfour\n\n", buf.String())
+}
+
+func TestWriteSubtitlesRequiresSegments(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Error(t, (&TranscriptionResponse{Text: "hello"}).WriteSRT(&buf, nil))
+	assert.Error(t, (&TranscriptionResponse{Text: "hello"}).WriteWebVTT(&buf, nil))
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, testTranscription().WriteText(&buf, &TextOptions{
+		ParagraphGap:  2 * time.Second,
+		MaxLineLength: 30,
+	}))
+
+	assert.Equal(t, "Hello and welcome. How can I\nhelp you today?\n\nI'd like to cancel.\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, (&TranscriptionResponse{Text: " Just text. "}).WriteText(&buf, nil))
+	assert.Equal(t, "Just text.\n", buf.String())
+}
@@ -0,0 +1,286 @@
+package mistral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// ToolRegistry declares function tools from Go types and dispatches the model's tool calls
+// to them. The parameter schema of each tool is derived from its argument struct with the
+// same rules as NewJSONSchema, so the schema sent to the model can't drift from the code
+// handling the calls.
+//
+// A ToolRegistry is not safe for concurrent registration, but Tools, DecodeArguments and
+// Call may be used concurrently once all tools are registered.
+type ToolRegistry struct {
+	tools map[string]*registeredTool
+	names []string
+}
+
+// registeredTool is a tool of a ToolRegistry.
+type registeredTool struct {
+	tool     Tool
+	argsType reflect.Type
+	fn       reflect.Value
+}
+
+// ToolArgumentsError is returned when the arguments of a tool call don't match the tool's
+// argument struct.
+type ToolArgumentsError struct {
+	// Name is the name of the called tool.
+	Name string
+
+	// Arguments is the JSON-encoded arguments sent by the model.
+	Arguments string
+
+	// Err is the decoding error.
+	Err error
+}
+
+// Error implements the error interface for ToolArgumentsError.
+func (e *ToolArgumentsError) Error() string {
+	return fmt.Sprintf("invalid arguments for tool %q: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the decoding error.
+func (e *ToolArgumentsError) Unwrap() error {
+	return e.Err
+}
+
+// NewToolRegistry creates an empty ToolRegistry.
+func NewToolRegistry() *ToolRegistry {
+	return &ToolRegistry{tools: make(map[string]*registeredTool)}
+}
+
+// Register declares a tool implemented by the Go function fn. fn must have one of the
+// following signatures, where A is a struct or a pointer to a struct describing the
+// arguments, and R is any type that can be encoded to JSON:
+//
+//	func(ctx context.Context, args A) (R, error)
+//	func(args A) (R, error)
+//
+// The parameter schema of the tool is derived from A, using the json, description and
+// enum struct tags as described in NewJSONSchema, and the tool is declared in strict mode.
+//
+// Example:
+//
+//	type WeatherArgs struct {
+//	    City string `json:"city" description:"The city name"`
+//	    Unit string `json:"unit,omitempty" enum:"celsius,fahrenheit"`
+//	}
+//
+//	tools := mistral.NewToolRegistry()
+//	err := tools.Register("get_weather", "Get the current weather in a city",
+//	    func(ctx context.Context, args WeatherArgs) (string, error) {
+//	        return fetchWeather(ctx, args.City, args.Unit)
+//	    })
+func (r *ToolRegistry) Register(name, description string, fn interface{}) error {
+	if fn == nil {
+		return fmt.Errorf("tool %q: fn must not be nil", name)
+	}
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func {
+		return fmt.Errorf("tool %q: expected a function, got %s", name, t)
+	}
+
+	in := t.NumIn()
+	if in == 2 && t.In(0) != contextType {
+		return fmt.Errorf("tool %q: the first parameter must be a context.Context", name)
+	}
+	if in != 1 && in != 2 {
+		return fmt.Errorf("tool %q: the function must take the arguments, optionally preceded by a context.Context", name)
+	}
+	if t.NumOut() != 2 || t.Out(1) != errorType {
+		return fmt.Errorf("tool %q: the function must return a result and an error", name)
+	}
+
+	return r.register(name, description, t.In(in-1), v)
+}
+
+// RegisterArgs declares a tool whose arguments are described by the struct args, without a
+// Go function implementing it. Use DecodeArguments to decode the model's calls to the tool
+// into a value of the type of args. Call returns an error for such tools.
+//
+// Example:
+//
+//	err := tools.RegisterArgs("search_orders", "Search the customer's orders", SearchOrdersArgs{})
+func (r *ToolRegistry) RegisterArgs(name, description string, args interface{}) error {
+	if args == nil {
+		return fmt.Errorf("tool %q: args must not be nil", name)
+	}
+	return r.register(name, description, reflect.TypeOf(args), reflect.Value{})
+}
+
+// register declares a tool with the given argument type and optional implementation.
+func (r *ToolRegistry) register(name, description string, argsType reflect.Type, fn reflect.Value) error {
+	if name == "" {
+		return errors.New("tool name must not be empty")
+	}
+	if _, ok := r.tools[name]; ok {
+		return fmt.Errorf("tool %q is already registered", name)
+	}
+
+	structType := argsType
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("tool %q: arguments must be a struct, got %s", name, argsType)
+	}
+
+	parameters, err := reflectSchema(structType)
+	if err != nil {
+		return fmt.Errorf("tool %q: %w", name, err)
+	}
+
+	r.tools[name] = &registeredTool{
+		tool: Tool{
+			Type: "function",
+			Function: ToolFunctionDetails{
+				Name:        name,
+				Description: description,
+				Parameters:  parameters,
+				Strict:      true,
+			},
+		},
+		argsType: argsType,
+		fn:       fn,
+	}
+	r.names = append(r.names, name)
+	return nil
+}
+
+// Tools returns the declarations of the registered tools, in registration order, for use
+// in ChatCompletionRequest.Tools or AgentsCompletionRequest.Tools.
+func (r *ToolRegistry) Tools() []Tool {
+	tools := make([]Tool, 0, len(r.names))
+	for _, name := range r.names {
+		tools = append(tools, r.tools[name].tool)
+	}
+	return tools
+}
+
+// DecodeArguments decodes the arguments of a call to a registered tool into a new value of
+// the tool's argument type, returned as an interface{} holding a pointer to the struct
+// (e.g., *WeatherArgs). Fields not declared by the argument struct are rejected.
+//
+// Returns:
+//   - The decoded arguments
+//   - An error if the tool is not registered, or a *ToolArgumentsError if the arguments
+//     don't match the argument struct
+//
+// Example:
+//
+//	args, err := tools.DecodeArguments(call.Function)
+//	if err != nil {
+//	    return err
+//	}
+//	switch args := args.(type) {
+//	case *SearchOrdersArgs:
+//	    orders := searchOrders(args.Query)
+//	}
+func (r *ToolRegistry) DecodeArguments(call FunctionCall) (interface{}, error) {
+	tool, ok := r.tools[call.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown tool %q", call.Name)
+	}
+
+	structType := tool.argsType
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	args := reflect.New(structType)
+
+	arguments := call.Arguments
+	if arguments == "" {
+		arguments = "{}"
+	}
+	decoder := json.NewDecoder(strings.NewReader(arguments))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(args.Interface()); err != nil {
+		return nil, &ToolArgumentsError{Name: call.Name, Arguments: call.Arguments, Err: err}
+	}
+	return args.Interface(), nil
+}
+
+// Call runs the Go function registered for a tool call and returns its result as a tool
+// message, ready to be appended to the conversation. Results are sent as-is if they are
+// strings, including empty ones, and encoded to JSON otherwise.
+//
+// Parameters:
+//   - ctx: Context passed to the tool function
+//   - call: The tool call requested by the model
+//
+// Returns:
+//   - A ChatMessage with the RoleTool role answering the call
+//   - An error if ctx is nil, the tool is not registered or was registered with RegisterArgs, a
+//     *ToolArgumentsError if the arguments don't match, or the error returned by the function
+//
+// Example:
+//
+//	msg := resp.Choices[0].Message
+//	messages = append(messages, msg)
+//	for _, call := range msg.ToolCalls {
+//	    result, err := tools.Call(ctx, call)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    messages = append(messages, result)
+//	}
+func (r *ToolRegistry) Call(ctx context.Context, call ToolCall) (ChatMessage, error) {
+	if ctx == nil {
+		return ChatMessage{}, errors.New("nil context")
+	}
+
+	args, err := r.DecodeArguments(call.Function)
+	if err != nil {
+		return ChatMessage{}, err
+	}
+
+	tool := r.tools[call.Function.Name]
+	if !tool.fn.IsValid() {
+		return ChatMessage{}, fmt.Errorf("tool %q has no function", call.Function.Name)
+	}
+
+	argsValue := reflect.ValueOf(args)
+	if tool.argsType.Kind() != reflect.Ptr {
+		argsValue = argsValue.Elem()
+	}
+	in := []reflect.Value{argsValue}
+	if tool.fn.Type().NumIn() == 2 {
+		in = []reflect.Value{reflect.ValueOf(ctx), argsValue}
+	}
+
+	out := tool.fn.Call(in)
+	if err, _ := out[1].Interface().(error); err != nil {
+		return ChatMessage{}, err
+	}
+
+	var content string
+	if s, ok := out[0].Interface().(string); ok {
+		content = s
+	} else {
+		data, err := json.Marshal(out[0].Interface())
+		if err != nil {
+			return ChatMessage{}, fmt.Errorf("failed to encode result of tool %q: %w", call.Function.Name, err)
+		}
+		content = string(data)
+	}
+
+	return ChatMessage{
+		Role:       RoleTool,
+		Content:    TextContent(content),
+		Name:       call.Function.Name,
+		ToolCallID: call.ID,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package mistral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type weatherArgs struct {
+	City string `json:"city" description:"The city name"`
+	Unit string `json:"unit,omitempty" enum:"celsius,fahrenheit"`
+}
+
+type weatherReport struct {
+	City        string  `json:"city"`
+	Temperature float64 `json:"temperature"`
+}
+
+type searchArgs struct {
+	Query string `json:"query"`
+}
+
+func newTestToolRegistry(t *testing.T) *ToolRegistry {
+	tools := NewToolRegistry()
+	require.NoError(t, tools.Register("get_weather", "Get the current weather",
+		func(ctx context.Context, args weatherArgs) (weatherReport, error) {
+			require.NotNil(t, ctx)
+			return weatherReport{City: args.City, Temperature: 21.5}, nil
+		}))
+	require.NoError(t, tools.Register("echo", "Echo the query",
+		func(args *searchArgs) (string, error) {
+			if args.Query == "" {
+				return "", errors.New("empty query")
+			}
+			return args.Query, nil
+		}))
+	require.NoError(t, tools.RegisterArgs("search_orders", "Search orders", searchArgs{}))
+	return tools
+}
+
+func TestToolRegistryTools(t *testing.T) {
+	tools := newTestToolRegistry(t).Tools()
+
+	require.Len(t, tools, 3)
+	assert.Equal(t, "get_weather", tools[0].Function.Name)
+	assert.Equal(t, "echo", tools[1].Function.Name)
+	assert.Equal(t, "search_orders", tools[2].Function.Name)
+
+	data, err := json.Marshal(tools[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "function",
+		"function": {
+			"name": "get_weather",
+			"description": "Get the current weather",
+			"strict": true,
+			"parameters": {
+				"type": "object",
+				"additionalProperties": false,
+				"required": ["city"],
+				"properties": {
+					"city": {"type": "string", "description": "The city name"},
+					"unit": {"type": "string", "enum": ["celsius", "fahrenheit"]}
+				}
+			}
+		}
+	}`, string(data))
+}
+
+func TestToolRegistryRegisterErrors(t *testing.T) {
+	tools := NewToolRegistry()
+
+	assert.Error(t, tools.Register("not_a_func", "", "hello"))
+	assert.Error(t, tools.Register("no_error", "", func(args searchArgs) string { return "" }))
+	assert.Error(t, tools.Register("bad_context", "", func(s string, args searchArgs) (string, error) { return "", nil }))
+	assert.Error(t, tools.Register("not_a_struct", "", func(query string) (string, error) { return "", nil }))
+	assert.Error(t, tools.RegisterArgs("nil_args", "", nil))
+
+	require.NoError(t, tools.RegisterArgs("search", "", searchArgs{}))
+	assert.ErrorContains(t, tools.RegisterArgs("search", "", searchArgs{}), "already registered")
+}
+
+func TestToolRegistryDecodeArguments(t *testing.T) {
+	tools := newTestToolRegistry(t)
+
+	args, err := tools.DecodeArguments(FunctionCall{Name: "search_orders", Arguments: `{"query": "shoes"}`})
+	require.NoError(t, err)
+	assert.Equal(t, &searchArgs{Query: "shoes"}, args)
+
+	_, err = tools.DecodeArguments(FunctionCall{Name: "search_orders", Arguments: `{"q": "shoes"}`})
+	var argsErr *ToolArgumentsError
+	require.ErrorAs(t, err, &argsErr)
+	assert.Equal(t, "search_orders", argsErr.Name)
+	assert.Equal(t, `{"q": "shoes"}`, argsErr.Arguments)
+
+	_, err = tools.DecodeArguments(FunctionCall{Name: "unknown", Arguments: `{}`})
+	assert.ErrorContains(t, err, "unknown tool")
+}
+
+func TestToolRegistryCall(t *testing.T) {
+	tools := newTestToolRegistry(t)
+	ctx := context.Background()
+
+	msg, err := tools.Call(ctx, ToolCall{
+		ID:       "call-1",
+		Type:     "function",
+		Function: FunctionCall{Name: "get_weather", Arguments: `{"city": "Paris"}`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, RoleTool, msg.Role)
+	assert.Equal(t, "call-1", msg.ToolCallID)
+	assert.Equal(t, "get_weather", msg.Name)
+	assert.JSONEq(t, `{"city": "Paris", "temperature": 21.5}`, msg.Content.Text())
+
+	msg, err = tools.Call(ctx, ToolCall{ID: "call-2", Function: FunctionCall{Name: "echo", Arguments: `{"query": "hi"}`}})
+	require.NoError(t, err)
+	assert.Equal(t, "hi", msg.Content.Text())
+
+	_, err = tools.Call(ctx, ToolCall{ID: "call-3", Function: FunctionCall{Name: "echo", Arguments: `{"query": ""}`}})
+	assert.EqualError(t, err, "empty query")
+
+	_, err = tools.Call(ctx, ToolCall{ID: "call-4", Function: FunctionCall{Name: "search_orders", Arguments: `{}`}})
+	assert.ErrorContains(t, err, "has no function")
+
+	// A nil context is rejected instead of panicking in reflect.
+	_, err = tools.Call(nil, ToolCall{ID: "call-5", Function: FunctionCall{Name: "get_weather", Arguments: `{"city": "Paris"}`}})
+	assert.EqualError(t, err, "nil context")
+}
+
+func TestToolRegistryCallEmptyResult(t *testing.T) {
+	tools := NewToolRegistry()
+	require.NoError(t, tools.Register("noop", "Do nothing", func(args searchArgs) (string, error) {
+		return "", nil
+	}))
+
+	msg, err := tools.Call(context.Background(), ToolCall{ID: "call-1", Function: FunctionCall{Name: "noop", Arguments: `{"query": "x"}`}})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &body))
+	assert.Equal(t, "", body["content"])
+}
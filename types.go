@@ -66,9 +66,9 @@ type ChatMessage struct {
 	// Role is the sender of the message (system, user, assistant, or tool).
 	Role Role `json:"role"`
 
-	// Content is the message content. Can be a string for simple text or an array of
-	// content parts for multimodal messages (text, images, etc.).
-	Content interface{} `json:"content"`
+	// Content is the message content: plain text created with TextContent, or chunks
+	// created with ChunkContent for multimodal messages (text, images, documents, audio).
+	Content Content `json:"content"`
 
 	// Name is an optional name of the message author, useful for distinguishing between
 	// multiple users or tools in a conversation.
@@ -142,6 +142,10 @@ type ToolFunctionDetails struct {
 	// follow the JSON Schema specification and define the expected structure, types,
 	// and constraints for the function's input.
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
+
+	// Strict enables strict schema adherence: when true, the model's arguments are
+	// guaranteed to match the Parameters schema.
+	Strict bool `json:"strict,omitempty"`
 }
 
 // ToolChoice represents the strategy for how the model should use the provided tools.
@@ -165,6 +169,16 @@ const (
 	ToolChoiceNone ToolChoice = "none"
 )
 
+// PromptMode selects the system prompt used by reasoning models. With PromptModeReasoning,
+// the model returns its reasoning trace as ThinkChunk content, separate from the answer.
+// Use Content.Thinking and Content.Text to read them.
+type PromptMode string
+
+const (
+	// PromptModeReasoning uses the reasoning system prompt.
+	PromptModeReasoning PromptMode = "reasoning"
+)
+
 // ResponseFormat specifies the desired format for the model's response.
 // This controls the structure of the generated output.
 type ResponseFormat struct {
@@ -172,7 +186,26 @@ type ResponseFormat struct {
 	//   - "text" - Standard text response (default)
 	//   - "json_object" - Response will be valid JSON. When using this mode,
 	//     you should also instruct the model to produce JSON in your prompt
+	//   - "json_schema" - Response will be valid JSON matching JSONSchema
 	Type string `json:"type"`
+
+	// JSONSchema is the schema the response must follow. Required when Type is "json_schema".
+	JSONSchema *JSONSchema `json:"json_schema,omitempty"`
+}
+
+// JSONSchema describes the structure of a JSON response.
+type JSONSchema struct {
+	// Name is the name of the schema.
+	Name string `json:"name"`
+
+	// Description is an optional description of the schema.
+	Description string `json:"description,omitempty"`
+
+	// Schema is the JSON Schema the response must follow.
+	Schema map[string]interface{} `json:"schema"`
+
+	// Strict enforces the schema exactly when true.
+	Strict bool `json:"strict,omitempty"`
 }
 
 // Usage represents token usage statistics for an API request.
@@ -210,9 +243,12 @@ type Model struct {
 	// Type is the type or category of model (e.g., "base", "fine-tuned").
 	Type string `json:"type,omitempty"`
 
-	// Capabilities is a list of capabilities the model supports, such as "completion",
-	// "chat", "embeddings", "function_calling".
-	Capabilities []string `json:"capabilities,omitempty"`
+	// Capabilities describes the features the model supports, such as chat completion,
+	// fill-in-the-middle, function calling or vision.
+	Capabilities ModelCapabilities `json:"capabilities"`
+
+	// Name is an optional human-readable name of the model.
+	Name string `json:"name,omitempty"`
 
 	// Description is a human-readable description of the model and its characteristics.
 	Description string `json:"description,omitempty"`
@@ -220,6 +256,24 @@ type Model struct {
 	// MaxTokens is the maximum number of tokens (input + output) the model can handle
 	// in a single request.
 	MaxTokens int `json:"max_tokens,omitempty"`
+
+	// MaxContextLength is the size of the model's context window, in tokens.
+	MaxContextLength int `json:"max_context_length,omitempty"`
+
+	// Aliases are alternative IDs that can be used to refer to the model.
+	Aliases []string `json:"aliases,omitempty"`
+
+	// Job is the ID of the fine-tuning job that produced the model. Only set for
+	// fine-tuned models.
+	Job string `json:"job,omitempty"`
+
+	// Root is the ID of the base model the model was fine-tuned from. Only set for
+	// fine-tuned models.
+	Root string `json:"root,omitempty"`
+
+	// Archived indicates whether the fine-tuned model is archived. Archived models are
+	// hidden from listings but can be unarchived with UnarchiveModel.
+	Archived bool `json:"archived,omitempty"`
 }
 
 // ModelList represents a paginated list of models returned by the API.
@@ -245,6 +299,12 @@ type DeleteModelResponse struct {
 	Deleted bool `json:"deleted"`
 }
 
+// FileSignedURL contains a temporary URL to download a file without authentication.
+type FileSignedURL struct {
+	// URL is the signed download URL. It stops working once it expires.
+	URL string `json:"url"`
+}
+
 // EmbeddingObject represents a single embedding vector and its metadata.
 // Embeddings are dense vector representations of text that capture semantic meaning,
 // useful for tasks like similarity search, clustering, and classification.
@@ -273,18 +333,32 @@ type File struct {
 	// Bytes is the size of the file in bytes.
 	Bytes int `json:"bytes"`
 
-	// CreatedAt is the timestamp when the file was uploaded.
-	CreatedAt time.Time `json:"created_at"`
+	// CreatedAt is the Unix timestamp (in seconds) when the file was uploaded.
+	CreatedAt int64 `json:"created_at"`
 
 	// Filename is the original name of the uploaded file.
 	Filename string `json:"filename"`
 
 	// Purpose is the intended purpose of the file (e.g., "fine-tune", "batch").
 	// This determines how the file can be used.
-	Purpose string `json:"purpose"`
+	Purpose FilePurpose `json:"purpose"`
+
+	// SampleType describes the content of the file, such as training samples or batch
+	// requests and results.
+	SampleType FileSampleType `json:"sample_type"`
+
+	// NumLines is the number of lines in the file, if known. It is set for JSONL files.
+	NumLines *int `json:"num_lines,omitempty"`
 
-	// Source contains optional information about the source or origin of the file.
-	Source string `json:"source,omitempty"`
+	// MimeType is the MIME type of the file, if known.
+	MimeType string `json:"mimetype,omitempty"`
+
+	// Source indicates where the file comes from: uploaded by a user, imported from a
+	// repository, or generated by Mistral (e.g., batch output files).
+	Source FileSource `json:"source"`
+
+	// Signature is a hash of the file's content, if computed.
+	Signature string `json:"signature,omitempty"`
 }
 
 // FileList represents a paginated list of files returned by the API.
@@ -336,8 +410,21 @@ type Agent struct {
 	// similar to system messages and define the agent's personality, constraints, and objectives.
 	Instructions string `json:"instructions,omitempty"`
 
-	// Tools is an array of tools/functions available to the agent for accomplishing tasks.
-	Tools []Tool `json:"tools,omitempty"`
+	// Tools is an array of tools available to the agent for accomplishing tasks, including
+	// functions and built-in connectors such as web search or the code interpreter.
+	Tools []AgentTool `json:"tools,omitempty"`
+
+	// CompletionArgs are the generation parameters used for the agent's responses.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+
+	// Handoffs are the IDs of the agents this agent can hand off conversations to.
+	Handoffs []string `json:"handoffs,omitempty"`
+
+	// Version is the current version of the agent. Every update creates a new version.
+	Version int `json:"version"`
+
+	// UpdatedAt is the timestamp when the agent was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
 
 	// Metadata contains custom key-value pairs for storing additional information about the agent.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
@@ -345,7 +432,8 @@ type Agent struct {
 
 // Conversation represents a persistent conversation thread.
 // Conversations maintain the message history and context for ongoing interactions,
-// optionally associated with a specific agent.
+// optionally associated with a specific agent. A conversation is either bound to a
+// model (Model is set) or to an agent (AgentID is set).
 type Conversation struct {
 	// ID is a unique identifier for the conversation.
 	ID string `json:"id"`
@@ -356,6 +444,27 @@ type Conversation struct {
 	// CreatedAt is the timestamp when the conversation was created.
 	CreatedAt time.Time `json:"created_at"`
 
+	// UpdatedAt is the timestamp when the conversation was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Name is an optional name given to the conversation.
+	Name string `json:"name,omitempty"`
+
+	// Description is an optional description of what the conversation is about.
+	Description string `json:"description,omitempty"`
+
+	// Instructions is the instruction prompt the model follows during the conversation.
+	// Only set for model conversations.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Tools is the list of tools available to the model during the conversation.
+	// Only set for model conversations.
+	Tools []AgentTool `json:"tools,omitempty"`
+
+	// CompletionArgs are the generation parameters used for the conversation.
+	// Only set for model conversations.
+	CompletionArgs *CompletionArgs `json:"completion_args,omitempty"`
+
 	// Model is an optional override for the model to use in this conversation. If not set,
 	// uses the agent's model (if associated with an agent) or a default model.
 	Model string `json:"model,omitempty"`
@@ -0,0 +1,175 @@
+package mistral
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const (
+	// defaultPollInterval is the delay before the second poll when PollOptions.Interval is 0.
+	defaultPollInterval = time.Second
+
+	// defaultMaxPollInterval caps the delay between polls when PollOptions.MaxInterval is 0.
+	defaultMaxPollInterval = 30 * time.Second
+
+	// defaultMaxConcurrency is the number of resources polled at once when
+	// PollOptions.MaxConcurrency is 0.
+	defaultMaxConcurrency = 4
+)
+
+// PollOptions configures how the WaitFor methods poll the API. The first poll happens
+// immediately; the delay between subsequent polls starts at Interval and doubles after
+// each poll, up to MaxInterval. Set MaxInterval to Interval to poll at a fixed rate.
+// Pass nil to use the defaults.
+type PollOptions struct {
+	// Interval is the delay between the first and second polls. If 0, 1 second is used.
+	Interval time.Duration
+
+	// MaxInterval is the maximum delay between polls. If 0, 30 seconds is used.
+	MaxInterval time.Duration
+
+	// Jitter randomizes each delay by up to this fraction of it, in either direction, to
+	// spread the load when many waiters poll at once. For example, 0.2 varies each delay
+	// by up to 20%. It must be between 0 and 1; 0 disables jitter.
+	Jitter float64
+
+	// MaxConcurrency is the maximum number of resources polled at once by the methods waiting
+	// for several resources, such as WaitForDocumentsProcessed. The others wait for a slot.
+	// If 0, 4 is used.
+	MaxConcurrency int
+}
+
+// DocumentProcessingError is returned when a library document fails to process.
+type DocumentProcessingError struct {
+	// Status is the final processing status of the document.
+	Status *ProcessingStatus
+}
+
+// Error implements the error interface for DocumentProcessingError.
+func (e *DocumentProcessingError) Error() string {
+	return fmt.Sprintf("document %s processing failed with status %q", e.Status.DocumentID, e.Status.ProcessingStatus)
+}
+
+// DocumentWaitResult is the outcome of waiting for a single document with
+// WaitForDocumentsProcessed.
+type DocumentWaitResult struct {
+	// DocumentID is the ID of the document.
+	DocumentID string
+
+	// Status is the last processing status retrieved for the document, if any.
+	Status *ProcessingStatus
+
+	// Err is the error that ended the wait, or nil if the document was processed.
+	Err error
+}
+
+// poll calls check until it reports done or returns an error, waiting between calls as
+// configured by opts. It returns ctx.Err() if ctx is done before check completes.
+func poll(ctx context.Context, opts *PollOptions, check func() (bool, error)) error {
+	interval := defaultPollInterval
+	maxInterval := defaultMaxPollInterval
+	var jitter float64
+	if opts != nil {
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+		if opts.Jitter > 0 && opts.Jitter <= 1 {
+			jitter = opts.Jitter
+		}
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		delay := interval
+		if jitter > 0 {
+			delay += time.Duration((rand.Float64()*2 - 1) * jitter * float64(interval))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// FineTuningJobUpdate describes the changes observed on a fine-tuning job between two polls
+// of WaitForFineTuningJob.
+type FineTuningJobUpdate struct {
+	// Job is the job as returned by the latest poll.
+	Job FineTuningJob
+
+	// StatusChanged indicates whether the job's status changed since the previous poll.
+	// It is true for the first poll.
+	StatusChanged bool
+
+	// NewEvents are the events that appeared since the previous poll.
+	NewEvents []FineTuningJobEvent
+
+	// NewCheckpoints are the checkpoints that appeared since the previous poll.
+	NewCheckpoints []FineTuningCheckpoint
+}
+
+// fineTuningJobTracker computes the FineTuningJobUpdate between successive polls of a job.
+type fineTuningJobTracker struct {
+	polled      bool
+	status      FineTuningJobStatus
+	events      map[string]bool
+	checkpoints map[int]bool
+}
+
+// update records job as the latest poll and returns what changed. ok is false if nothing did.
+func (t *fineTuningJobTracker) update(job FineTuningJob) (update FineTuningJobUpdate, ok bool) {
+	if t.events == nil {
+		t.events = make(map[string]bool)
+		t.checkpoints = make(map[int]bool)
+	}
+
+	details := job.Details()
+	update.Job = job
+	update.StatusChanged = !t.polled || details.Status != t.status
+	t.polled = true
+	t.status = details.Status
+
+	// Events have no ID and a second resolution, so they are identified by their content,
+	// numbered to tell identical events apart.
+	seen := make(map[string]int)
+	for _, event := range details.Events {
+		data, _ := json.Marshal(event)
+		seen[string(data)]++
+		key := fmt.Sprintf("%s#%d", data, seen[string(data)])
+		if !t.events[key] {
+			t.events[key] = true
+			update.NewEvents = append(update.NewEvents, event)
+		}
+	}
+	for _, checkpoint := range details.Checkpoints {
+		if !t.checkpoints[checkpoint.StepNumber] {
+			t.checkpoints[checkpoint.StepNumber] = true
+			update.NewCheckpoints = append(update.NewCheckpoints, checkpoint)
+		}
+	}
+
+	ok = update.StatusChanged || len(update.NewEvents) > 0 || len(update.NewCheckpoints) > 0
+	return update, ok
+}